@@ -1,13 +1,18 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 )
 
 // SubmitAction runs an action on the CloudBolt resource or server
 func (c *CloudBoltClient) SubmitAction(actionPath string, resourcePath string, parameters map[string]interface{}) (*CloudBoltRunActionResult, error) {
+	return c.SubmitActionWithContext(context.Background(), actionPath, resourcePath, parameters)
+}
+
+// SubmitActionWithContext is the same as SubmitAction with a caller-provided context.
+func (c *CloudBoltClient) SubmitActionWithContext(ctx context.Context, actionPath string, resourcePath string, parameters map[string]interface{}) (*CloudBoltRunActionResult, error) {
 	apiurl := c.baseURL
 	apiurl.Path = fmt.Sprintf("%srunAction/", actionPath)
 
@@ -24,11 +29,14 @@ func (c *CloudBoltClient) SubmitAction(actionPath string, resourcePath string, p
 		return nil, err
 	}
 
-	log.Printf("[!!] JSON payload in POST request to Deploy Blueprint:\n%s", string(reqJSON))
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	err = checkHttpStatus(resp)
 	if err != nil {
-		log.Fatalln(err)
 		return nil, err
 	}
 
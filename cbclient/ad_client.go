@@ -1,11 +1,10 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"net/url"
 	"strconv"
 )
 
@@ -16,6 +15,23 @@ type ADPolicyResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the ADPolicies on this page of results.
+func (r *ADPolicyResult) Items() []ADPolicy {
+	return r.Embedded.ADPolicies
+}
+
+type MicrosoftADComputerAccountResult struct {
+	CloudBoltResult
+	Embedded struct {
+		ComputerAccounts []MicrosoftADComputerAccount `json:"microsoftADComputerAccounts"`
+	} `json:"_embedded"`
+}
+
+// Items returns the Microsoft AD Computer Accounts on this page of results.
+func (r *MicrosoftADComputerAccountResult) Items() []MicrosoftADComputerAccount {
+	return r.Embedded.ComputerAccounts
+}
+
 type ADPolicy struct {
 	Links *struct {
 		Self      CloudBoltHALItem `json:"self,omitempty"`
@@ -62,17 +78,31 @@ type MicrosoftADComputerAccount struct {
 	TemplateProperties map[string]interface{} `json:"templateProperties"`
 }
 
-func (c *CloudBoltClient) GetADPolicy(name string) (*ADPolicy, error) {
+func (c *CloudBoltClient) GetADPolicy(name string, filters ...*Filter) (*ADPolicy, error) {
+	return c.GetADPolicyWithContext(context.Background(), name, filters...)
+}
+
+// GetADPolicyWithContext is the same as GetADPolicy with a caller-provided context.
+func (c *CloudBoltClient) GetADPolicyWithContext(ctx context.Context, name string, filters ...*Filter) (*ADPolicy, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "microsoftADPolicies")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res ADPolicyResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -89,10 +119,15 @@ func (c *CloudBoltClient) GetADPolicy(name string) (*ADPolicy, error) {
 }
 
 func (c *CloudBoltClient) CreateMicrosoftADComputerAccount(computerAccount *MicrosoftADComputerAccount) (*OneFuseJobStatus, error) {
-	log.Println("onefuse.apiClient: CreateMicrosoftADComputerAccount")
+	return c.CreateMicrosoftADComputerAccountWithContext(context.Background(), computerAccount)
+}
+
+// CreateMicrosoftADComputerAccountWithContext is the same as CreateMicrosoftADComputerAccount with a caller-provided context.
+func (c *CloudBoltClient) CreateMicrosoftADComputerAccountWithContext(ctx context.Context, computerAccount *MicrosoftADComputerAccount) (*OneFuseJobStatus, error) {
+	c.log().Debug("onefuse.apiClient: CreateMicrosoftADComputerAccount")
 
 	if computerAccount.WorkspaceURL == "" {
-		workspace, err := c.GetDefaultWorkSpace()
+		workspace, err := c.GetDefaultWorkSpaceWithContext(ctx)
 
 		if err != nil {
 			return nil, err
@@ -119,10 +154,11 @@ func (c *CloudBoltClient) CreateMicrosoftADComputerAccount(computerAccount *Micr
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "microsoftADComputerAccounts")
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -134,13 +170,22 @@ func (c *CloudBoltClient) CreateMicrosoftADComputerAccount(computerAccount *Micr
 }
 
 func (c *CloudBoltClient) GetMicrosoftADComputerAccount(computerAccountPath string) (*MicrosoftADComputerAccount, error) {
+	return c.GetMicrosoftADComputerAccountWithContext(context.Background(), computerAccountPath)
+}
+
+// GetMicrosoftADComputerAccountWithContext is the same as GetMicrosoftADComputerAccount with a caller-provided context.
+func (c *CloudBoltClient) GetMicrosoftADComputerAccountWithContext(ctx context.Context, computerAccountPath string) (*MicrosoftADComputerAccount, error) {
 	apiurl := c.baseURL
 	apiurl.Path = computerAccountPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -152,13 +197,22 @@ func (c *CloudBoltClient) GetMicrosoftADComputerAccount(computerAccountPath stri
 }
 
 func (c *CloudBoltClient) GetMicrosoftADComputerAccountById(computerAccountId string) (*MicrosoftADComputerAccount, error) {
+	return c.GetMicrosoftADComputerAccountByIdWithContext(context.Background(), computerAccountId)
+}
+
+// GetMicrosoftADComputerAccountByIdWithContext is the same as GetMicrosoftADComputerAccountById with a caller-provided context.
+func (c *CloudBoltClient) GetMicrosoftADComputerAccountByIdWithContext(ctx context.Context, computerAccountId string) (*MicrosoftADComputerAccount, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "microsoftADComputerAccounts", computerAccountId)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -170,13 +224,19 @@ func (c *CloudBoltClient) GetMicrosoftADComputerAccountById(computerAccountId st
 }
 
 func (c *CloudBoltClient) DeleteMicrosoftADComputerAccount(computerAccountId string) (*OneFuseJobStatus, error) {
+	return c.DeleteMicrosoftADComputerAccountWithContext(context.Background(), computerAccountId)
+}
+
+// DeleteMicrosoftADComputerAccountWithContext is the same as DeleteMicrosoftADComputerAccount with a caller-provided context.
+func (c *CloudBoltClient) DeleteMicrosoftADComputerAccountWithContext(ctx context.Context, computerAccountId string) (*OneFuseJobStatus, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "microsoftADComputerAccounts", computerAccountId)
 
-	resp, err := c.makeRequest("DELETE", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "DELETE", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -188,10 +248,15 @@ func (c *CloudBoltClient) DeleteMicrosoftADComputerAccount(computerAccountId str
 }
 
 func (c *CloudBoltClient) CreateMicrosoftADPolicy(newPolicy *MicrosoftADPolicy) (*MicrosoftADPolicy, error) {
-	log.Println("onefuse.apiClient: CreateModuleDeployment")
+	return c.CreateMicrosoftADPolicyWithContext(context.Background(), newPolicy)
+}
+
+// CreateMicrosoftADPolicyWithContext is the same as CreateMicrosoftADPolicy with a caller-provided context.
+func (c *CloudBoltClient) CreateMicrosoftADPolicyWithContext(ctx context.Context, newPolicy *MicrosoftADPolicy) (*MicrosoftADPolicy, error) {
+	c.log().Debug("onefuse.apiClient: CreateMicrosoftADPolicy")
 
 	if newPolicy.WorkspaceURL == "" {
-		workspace, err := c.GetDefaultWorkSpace()
+		workspace, err := c.GetDefaultWorkSpaceWithContext(ctx)
 
 		if err != nil {
 			return nil, err
@@ -208,10 +273,11 @@ func (c *CloudBoltClient) CreateMicrosoftADPolicy(newPolicy *MicrosoftADPolicy)
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "microsoftADPolicies")
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	err = checkHttpStatus(resp)
@@ -226,13 +292,22 @@ func (c *CloudBoltClient) CreateMicrosoftADPolicy(newPolicy *MicrosoftADPolicy)
 }
 
 func (c *CloudBoltClient) GetMicrosoftADPolicyByID(policyId string) (*MicrosoftADPolicy, error) {
+	return c.GetMicrosoftADPolicyByIDWithContext(context.Background(), policyId)
+}
+
+// GetMicrosoftADPolicyByIDWithContext is the same as GetMicrosoftADPolicyByID with a caller-provided context.
+func (c *CloudBoltClient) GetMicrosoftADPolicyByIDWithContext(ctx context.Context, policyId string) (*MicrosoftADPolicy, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "microsoftADPolicies", policyId)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -244,6 +319,11 @@ func (c *CloudBoltClient) GetMicrosoftADPolicyByID(policyId string) (*MicrosoftA
 }
 
 func (c *CloudBoltClient) UpdateMicrosoftADPolicy(policyId string, updatedPolicy *MicrosoftADPolicy) (*MicrosoftADPolicy, error) {
+	return c.UpdateMicrosoftADPolicyWithContext(context.Background(), policyId, updatedPolicy)
+}
+
+// UpdateMicrosoftADPolicyWithContext is the same as UpdateMicrosoftADPolicy with a caller-provided context.
+func (c *CloudBoltClient) UpdateMicrosoftADPolicyWithContext(ctx context.Context, policyId string, updatedPolicy *MicrosoftADPolicy) (*MicrosoftADPolicy, error) {
 	reqJSON, err := json.Marshal(updatedPolicy)
 	if err != nil {
 		return nil, err
@@ -252,10 +332,11 @@ func (c *CloudBoltClient) UpdateMicrosoftADPolicy(policyId string, updatedPolicy
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "microsoftADPolicies", policyId)
 
-	resp, err := c.makeRequest("PUT", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "PUT", apiurl.String(), reqJSON)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	err = checkHttpStatus(resp)
@@ -270,13 +351,19 @@ func (c *CloudBoltClient) UpdateMicrosoftADPolicy(policyId string, updatedPolicy
 }
 
 func (c *CloudBoltClient) DeleteMicrosoftADPolicy(policyId string) error {
+	return c.DeleteMicrosoftADPolicyWithContext(context.Background(), policyId)
+}
+
+// DeleteMicrosoftADPolicyWithContext is the same as DeleteMicrosoftADPolicy with a caller-provided context.
+func (c *CloudBoltClient) DeleteMicrosoftADPolicyWithContext(ctx context.Context, policyId string) error {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "microsoftADPolicies", policyId)
 
-	resp, err := c.makeRequest("DELETE", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "DELETE", apiurl.String(), nil)
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	err = checkHttpStatus(resp)
@@ -286,3 +373,25 @@ func (c *CloudBoltClient) DeleteMicrosoftADPolicy(policyId string) error {
 
 	return nil
 }
+
+// ListADPolicies fetches every Microsoft AD Policy the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListADPolicies(opts *ListOptions) ([]ADPolicy, error) {
+	return c.ListADPoliciesWithContext(context.Background(), opts)
+}
+
+// ListADPoliciesWithContext is the same as ListADPolicies with a caller-provided context.
+func (c *CloudBoltClient) ListADPoliciesWithContext(ctx context.Context, opts *ListOptions) ([]ADPolicy, error) {
+	return NewPaginator[ADPolicy, ADPolicyResult](c, c.apiEndpoint("onefuse", "microsoftADPolicies"), opts).ListAll(ctx)
+}
+
+// ListMicrosoftADComputerAccounts fetches every Microsoft AD Computer Account the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., NewFilter().Policy(2).Archived(false).
+func (c *CloudBoltClient) ListMicrosoftADComputerAccounts(opts *ListOptions) ([]MicrosoftADComputerAccount, error) {
+	return c.ListMicrosoftADComputerAccountsWithContext(context.Background(), opts)
+}
+
+// ListMicrosoftADComputerAccountsWithContext is the same as ListMicrosoftADComputerAccounts with a caller-provided context.
+func (c *CloudBoltClient) ListMicrosoftADComputerAccountsWithContext(ctx context.Context, opts *ListOptions) ([]MicrosoftADComputerAccount, error) {
+	return NewPaginator[MicrosoftADComputerAccount, MicrosoftADComputerAccountResult](c, c.apiEndpoint("onefuse", "microsoftADComputerAccounts"), opts).ListAll(ctx)
+}
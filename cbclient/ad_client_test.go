@@ -361,3 +361,59 @@ func verifyMicrosoftADPolicy(policy *MicrosoftADPolicy) {
 	Expect(policy.SecurityGroups[0]).To(Equal("CN=TestSecurityGroup,OU=OneFuse,DC=example,DC=net"))
 	Expect(policy.SecurityGroups[1]).To(Equal("CN=TestSecurityGroupTemp,OU=TEMPOU1,DC=example,DC=net"))
 }
+
+func TestListADPolicies(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListADPolicies)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	policies, err := client.ListADPolicies(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Microsoft AD Policies, get a token
+	// 3. Successfully getting the only page of Microsoft AD Policies
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/microsoftADPolicies/"))
+
+	Expect(policies).To(HaveLen(1))
+	Expect(policies[0].ID).To(Equal(17))
+}
+
+func TestListMicrosoftADComputerAccounts(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListMicrosoftADComputerAccounts)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	accounts, err := client.ListMicrosoftADComputerAccounts(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Microsoft AD Computer Accounts, get a token
+	// 3. Successfully getting the only page of Microsoft AD Computer Accounts
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/microsoftADComputerAccounts/"))
+
+	Expect(accounts).To(HaveLen(1))
+	Expect(accounts[0].ID).To(Equal(23))
+}
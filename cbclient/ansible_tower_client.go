@@ -1,11 +1,10 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"net/url"
 	"strconv"
 )
 
@@ -16,15 +15,44 @@ type AnsibleTowerPolicyResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the AnsibleTowerPolicies on this page of results.
+func (r *AnsibleTowerPolicyResult) Items() []AnsibleTowerPolicy {
+	return r.Embedded.AnsibleTowerPolicies
+}
+
+type AnsibleTowerDeploymentResult struct {
+	CloudBoltResult
+	Embedded struct {
+		AnsibleTowerDeployments []AnsibleTowerDeployment `json:"ansibleTowerDeployments"`
+	} `json:"_embedded"`
+}
+
+// Items returns the Ansible Tower Deployments on this page of results.
+func (r *AnsibleTowerDeploymentResult) Items() []AnsibleTowerDeployment {
+	return r.Embedded.AnsibleTowerDeployments
+}
+
 type AnsibleTowerPolicy struct {
 	Links *struct {
 		Self      CloudBoltHALItem `json:"self,omitempty"`
 		Workspace CloudBoltHALItem `json:"workspace,omitempty"`
 		Endpoint  CloudBoltHALItem `json:"endpoint,omitempty"`
 	} `json:"_links,omitempty"`
-	ID          int    `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	WorkspaceURL string `json:"workspace,omitempty"`
+	// Endpoint is the URL of the Ansible Tower endpoint, e.g., "/api/v3/onefuse/endpoints/192/"
+	Endpoint         string `json:"endpoint,omitempty"`
+	OrganizationName string `json:"organizationName,omitempty"`
+	InventoryName    string `json:"inventoryName,omitempty"`
+	Groups           string `json:"groups,omitempty"`
+	// The job templates are JSON lists, e.g., `[{"name": "{{job_template_name}}"}]`
+	ProvisioningJobTemplates     string `json:"provisioningJobTemplates,omitempty"`
+	DeprovisioningJobTemplates   string `json:"deprovisioningJobTemplates,omitempty"`
+	VerifyPromptOnLaunchForLimit bool   `json:"verifyPromptOnLaunchForLimit"`
+	MachineCredentialOverride    string `json:"machineCredentialOverride,omitempty"`
+	ExtraVarsOverride            string `json:"extraVarsOverride,omitempty"`
 }
 
 type AnsibleTowerDeployment struct {
@@ -55,19 +83,33 @@ type AnsibleTowerDeployment struct {
 	TemplateProperties map[string]interface{} `json:"templateProperties"`
 }
 
-func (c *CloudBoltClient) GetAnsibleTowerPolicy(name string) (*AnsibleTowerPolicy, error) {
-	log.Println("onefuse.apiClient: GetAnsibleTowerPolicyByName")
+func (c *CloudBoltClient) GetAnsibleTowerPolicy(name string, filters ...*Filter) (*AnsibleTowerPolicy, error) {
+	return c.GetAnsibleTowerPolicyWithContext(context.Background(), name, filters...)
+}
+
+// GetAnsibleTowerPolicyWithContext is the same as GetAnsibleTowerPolicy with a caller-provided context.
+func (c *CloudBoltClient) GetAnsibleTowerPolicyWithContext(ctx context.Context, name string, filters ...*Filter) (*AnsibleTowerPolicy, error) {
+	c.log().Debug("onefuse.apiClient: GetAnsibleTowerPolicy")
+
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
 
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "ansibleTowerPolicies")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res AnsibleTowerPolicyResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -84,10 +126,15 @@ func (c *CloudBoltClient) GetAnsibleTowerPolicy(name string) (*AnsibleTowerPolic
 }
 
 func (c *CloudBoltClient) CreateAnsibleTowerDeployment(ansibleTowerDeployment *AnsibleTowerDeployment) (*OneFuseJobStatus, error) {
-	log.Println("onefuse.apiClient: CreateAnsibleTowerDeployment")
+	return c.CreateAnsibleTowerDeploymentWithContext(context.Background(), ansibleTowerDeployment)
+}
+
+// CreateAnsibleTowerDeploymentWithContext is the same as CreateAnsibleTowerDeployment with a caller-provided context.
+func (c *CloudBoltClient) CreateAnsibleTowerDeploymentWithContext(ctx context.Context, ansibleTowerDeployment *AnsibleTowerDeployment) (*OneFuseJobStatus, error) {
+	c.log().Debug("onefuse.apiClient: CreateAnsibleTowerDeployment")
 
 	if ansibleTowerDeployment.WorkspaceURL == "" {
-		workspace, err := c.GetDefaultWorkSpace()
+		workspace, err := c.GetDefaultWorkSpaceWithContext(ctx)
 
 		if err != nil {
 			return nil, err
@@ -114,10 +161,11 @@ func (c *CloudBoltClient) CreateAnsibleTowerDeployment(ansibleTowerDeployment *A
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "ansibleTowerDeployments")
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -129,13 +177,22 @@ func (c *CloudBoltClient) CreateAnsibleTowerDeployment(ansibleTowerDeployment *A
 }
 
 func (c *CloudBoltClient) GetAnsibleTowerDeployment(ansibleDeploymentPath string) (*AnsibleTowerDeployment, error) {
+	return c.GetAnsibleTowerDeploymentWithContext(context.Background(), ansibleDeploymentPath)
+}
+
+// GetAnsibleTowerDeploymentWithContext is the same as GetAnsibleTowerDeployment with a caller-provided context.
+func (c *CloudBoltClient) GetAnsibleTowerDeploymentWithContext(ctx context.Context, ansibleDeploymentPath string) (*AnsibleTowerDeployment, error) {
 	apiurl := c.baseURL
 	apiurl.Path = ansibleDeploymentPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -147,13 +204,22 @@ func (c *CloudBoltClient) GetAnsibleTowerDeployment(ansibleDeploymentPath string
 }
 
 func (c *CloudBoltClient) GetAnsibleTowerDeploymentById(ansibleDeploymentId string) (*AnsibleTowerDeployment, error) {
+	return c.GetAnsibleTowerDeploymentByIdWithContext(context.Background(), ansibleDeploymentId)
+}
+
+// GetAnsibleTowerDeploymentByIdWithContext is the same as GetAnsibleTowerDeploymentById with a caller-provided context.
+func (c *CloudBoltClient) GetAnsibleTowerDeploymentByIdWithContext(ctx context.Context, ansibleDeploymentId string) (*AnsibleTowerDeployment, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "ansibleTowerDeployments", ansibleDeploymentId)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -165,13 +231,19 @@ func (c *CloudBoltClient) GetAnsibleTowerDeploymentById(ansibleDeploymentId stri
 }
 
 func (c *CloudBoltClient) DeleteAnsibleTowerDeployment(ansibleDeploymentId string) (*OneFuseJobStatus, error) {
+	return c.DeleteAnsibleTowerDeploymentWithContext(context.Background(), ansibleDeploymentId)
+}
+
+// DeleteAnsibleTowerDeploymentWithContext is the same as DeleteAnsibleTowerDeployment with a caller-provided context.
+func (c *CloudBoltClient) DeleteAnsibleTowerDeploymentWithContext(ctx context.Context, ansibleDeploymentId string) (*OneFuseJobStatus, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "ansibleTowerDeployments", ansibleDeploymentId)
 
-	resp, err := c.makeRequest("DELETE", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "DELETE", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -181,3 +253,84 @@ func (c *CloudBoltClient) DeleteAnsibleTowerDeployment(ansibleDeploymentId strin
 
 	return job_status, nil
 }
+
+// ListAnsibleTowerPolicies fetches every Ansible Tower Policy the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListAnsibleTowerPolicies(opts *ListOptions) ([]AnsibleTowerPolicy, error) {
+	return c.ListAnsibleTowerPoliciesWithContext(context.Background(), opts)
+}
+
+// ListAnsibleTowerPoliciesWithContext is the same as ListAnsibleTowerPolicies with a caller-provided context.
+func (c *CloudBoltClient) ListAnsibleTowerPoliciesWithContext(ctx context.Context, opts *ListOptions) ([]AnsibleTowerPolicy, error) {
+	return NewPaginator[AnsibleTowerPolicy, AnsibleTowerPolicyResult](c, c.apiEndpoint("onefuse", "ansibleTowerPolicies"), opts).ListAll(ctx)
+}
+
+// ListAnsibleTowerDeployments fetches every Ansible Tower Deployment the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., NewFilter().Policy(2).Archived(false).
+func (c *CloudBoltClient) ListAnsibleTowerDeployments(opts *ListOptions) ([]AnsibleTowerDeployment, error) {
+	return c.ListAnsibleTowerDeploymentsWithContext(context.Background(), opts)
+}
+
+// ListAnsibleTowerDeploymentsWithContext is the same as ListAnsibleTowerDeployments with a caller-provided context.
+func (c *CloudBoltClient) ListAnsibleTowerDeploymentsWithContext(ctx context.Context, opts *ListOptions) ([]AnsibleTowerDeployment, error) {
+	return NewPaginator[AnsibleTowerDeployment, AnsibleTowerDeploymentResult](c, c.apiEndpoint("onefuse", "ansibleTowerDeployments"), opts).ListAll(ctx)
+}
+
+// CreateAnsibleTowerPolicy creates a Ansible Tower Policy in OneFuse and returns it as saved.
+// If newPolicy has no WorkspaceURL it goes in the default workspace.
+func (c *CloudBoltClient) CreateAnsibleTowerPolicy(newPolicy *AnsibleTowerPolicy) (*AnsibleTowerPolicy, error) {
+	return c.CreateAnsibleTowerPolicyWithContext(context.Background(), newPolicy)
+}
+
+// CreateAnsibleTowerPolicyWithContext is the same as CreateAnsibleTowerPolicy with a caller-provided context.
+func (c *CloudBoltClient) CreateAnsibleTowerPolicyWithContext(ctx context.Context, newPolicy *AnsibleTowerPolicy) (*AnsibleTowerPolicy, error) {
+	var policy AnsibleTowerPolicy
+	err := c.createPolicy(ctx, "ansibleTowerPolicies", &newPolicy.WorkspaceURL, newPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// GetAnsibleTowerPolicyByID fetches the Ansible Tower Policy with the given ID.
+func (c *CloudBoltClient) GetAnsibleTowerPolicyByID(policyId string) (*AnsibleTowerPolicy, error) {
+	return c.GetAnsibleTowerPolicyByIDWithContext(context.Background(), policyId)
+}
+
+// GetAnsibleTowerPolicyByIDWithContext is the same as GetAnsibleTowerPolicyByID with a caller-provided context.
+func (c *CloudBoltClient) GetAnsibleTowerPolicyByIDWithContext(ctx context.Context, policyId string) (*AnsibleTowerPolicy, error) {
+	var policy AnsibleTowerPolicy
+	err := c.getPolicy(ctx, "ansibleTowerPolicies", policyId, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// UpdateAnsibleTowerPolicy replaces the Ansible Tower Policy with the given ID and returns it as saved.
+func (c *CloudBoltClient) UpdateAnsibleTowerPolicy(policyId string, updatedPolicy *AnsibleTowerPolicy) (*AnsibleTowerPolicy, error) {
+	return c.UpdateAnsibleTowerPolicyWithContext(context.Background(), policyId, updatedPolicy)
+}
+
+// UpdateAnsibleTowerPolicyWithContext is the same as UpdateAnsibleTowerPolicy with a caller-provided context.
+func (c *CloudBoltClient) UpdateAnsibleTowerPolicyWithContext(ctx context.Context, policyId string, updatedPolicy *AnsibleTowerPolicy) (*AnsibleTowerPolicy, error) {
+	var policy AnsibleTowerPolicy
+	err := c.updatePolicy(ctx, "ansibleTowerPolicies", policyId, updatedPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// DeleteAnsibleTowerPolicy deletes the Ansible Tower Policy with the given ID.
+func (c *CloudBoltClient) DeleteAnsibleTowerPolicy(policyId string) error {
+	return c.DeleteAnsibleTowerPolicyWithContext(context.Background(), policyId)
+}
+
+// DeleteAnsibleTowerPolicyWithContext is the same as DeleteAnsibleTowerPolicy with a caller-provided context.
+func (c *CloudBoltClient) DeleteAnsibleTowerPolicyWithContext(ctx context.Context, policyId string) error {
+	return c.deletePolicy(ctx, "ansibleTowerPolicies", policyId)
+}
@@ -188,3 +188,205 @@ func TestCreateAnsibleTowerDeployment(t *testing.T) {
 	// The CloudBolt Order object should be parsed correctly
 	verifyJobStatus(jobStatus)
 }
+
+func TestListAnsibleTowerPolicies(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListAnsibleTowerPolicies)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	policies, err := client.ListAnsibleTowerPolicies(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Ansible Tower Policies, get a token
+	// 3. Successfully getting the only page of Ansible Tower Policies
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ansibleTowerPolicies/"))
+
+	Expect(policies).To(HaveLen(1))
+	Expect(policies[0].ID).To(Equal(6))
+}
+
+func TestListAnsibleTowerDeployments(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListAnsibleTowerDeployments)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	deployments, err := client.ListAnsibleTowerDeployments(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Ansible Tower Deployments, get a token
+	// 3. Successfully getting the only page of Ansible Tower Deployments
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ansibleTowerDeployments/"))
+
+	Expect(deployments).To(HaveLen(1))
+	Expect(deployments[0].ID).To(Equal(4))
+}
+
+func TestCreateAnsibleTowerPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleAnsibleTowerPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	newPolicy := AnsibleTowerPolicy{
+		Name:                     "My_Ansible_Tower_Policy",
+		Description:              "An Ansible Policy created through automated tests",
+		WorkspaceURL:             "/api/v3/onefuse/workspaces/2/",
+		Endpoint:                 "/api/v3/onefuse/endpoints/192/",
+		OrganizationName:         "{{org_name}}",
+		ProvisioningJobTemplates: `[{"name": "{{job_template_name}}"}]`,
+	}
+
+	policy, err := client.CreateAnsibleTowerPolicy(&newPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to create, get a token
+	// 3. Successful create
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ansibleTowerPolicies/"))
+
+	verifyAnsibleTowerPolicy(policy)
+}
+
+func TestGetAnsibleTowerPolicyByID(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleAnsibleTowerPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	policy, err := client.GetAnsibleTowerPolicyByID("6")
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get, get a token
+	// 3. Successful Get
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ansibleTowerPolicies/6/"))
+
+	verifyAnsibleTowerPolicy(policy)
+}
+
+func TestUpdateAnsibleTowerPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleAnsibleTowerPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	updatedPolicy := AnsibleTowerPolicy{
+		Name:                     "My_Ansible_Tower_Policy",
+		Description:              "An Ansible Policy created through automated tests",
+		WorkspaceURL:             "/api/v3/onefuse/workspaces/2/",
+		Endpoint:                 "/api/v3/onefuse/endpoints/192/",
+		OrganizationName:         "{{org_name}}",
+		ProvisioningJobTemplates: `[{"name": "{{job_template_name}}"}]`,
+	}
+
+	policy, err := client.UpdateAnsibleTowerPolicy("6", &updatedPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to update, get a token
+	// 3. Successful update
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("PUT"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ansibleTowerPolicies/6/"))
+
+	verifyAnsibleTowerPolicy(policy)
+}
+
+func TestDeleteAnsibleTowerPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleAnsibleTowerPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	err := client.DeleteAnsibleTowerPolicy("6")
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to delete, get a token
+	// 3. Successful delete
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("DELETE"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ansibleTowerPolicies/6/"))
+}
+
+func verifyAnsibleTowerPolicy(policy *AnsibleTowerPolicy) {
+	Expect(policy.Links.Self.Href).To(Equal("/api/v3/onefuse/ansibleTowerPolicies/6/"))
+	Expect(policy.Links.Self.Title).To(Equal("My_Ansible_Tower_Policy"))
+	Expect(policy.Links.Workspace.Href).To(Equal("/api/v3/onefuse/workspaces/2/"))
+	Expect(policy.ID).To(Equal(6))
+	Expect(policy.Name).To(Equal("My_Ansible_Tower_Policy"))
+	Expect(policy.OrganizationName).To(Equal("{{org_name}}"))
+	Expect(policy.ProvisioningJobTemplates).To(Equal(`[{"name": "{{job_template_name}}"}]`))
+	Expect(policy.VerifyPromptOnLaunchForLimit).To(Equal(false))
+	Expect(policy.InventoryName).To(Equal(""))
+}
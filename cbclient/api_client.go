@@ -12,35 +12,59 @@ Organization note:
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
+	"strings"
+	"sync"
 	"time"
 )
 
-const filterByName string = "filter=name:%s"
-
 // CloudBoltClient stores the important metadata necessary to make API requests.
 // - BaseURL follows the pattern "https://cloudbolt.myco.ext:443/".
+// - PathPrefix is prepended to every request path, for installs behind a reverse proxy.
 // - HTTPClient is a client used to make the API calls.
-// - Token is retrieved in `New` and is included in the Bearer Token of request headers.
+// - Token is retrieved from the TokenProvider and is included in the Bearer Token of request headers.
+// - Logger receives diagnostic output; it is silent unless SetLogger is called.
+// - RetryPolicy controls retries of transient failures; there are none unless SetRetryPolicy is called.
+//
+// A CloudBoltClient is safe for concurrent use by multiple goroutines once it is configured.
+// When several requests find the token expired at once, they share a single authentication.
+// The Set* methods are not synchronized and should be called before the client is shared.
 type CloudBoltClient struct {
-	baseURL    url.URL
-	httpClient *http.Client
-	password   string
-	token      string
-	username   string
-	domain     string
+	baseURL     url.URL
+	pathPrefix  string
+	httpClient  *http.Client
+	userAgent   string
+	password    string
+	username    string
+	domain      string
+	logger      Logger
+	retryPolicy *RetryPolicy
+
+	// tokenMu guards everything below it
+	tokenMu          sync.Mutex
+	tokenProvider    TokenProvider
+	token            string
+	tokenExpiry      time.Time
+	proactiveRefresh bool
+	refreshWindow    time.Duration
+	authCall         *authCall
 }
 
 // CloudBoltResult stores the response of paginated calls like `/api/v2/blueprints/`
 // These include a link to the page and an `embedded` list of response objects.
+// Next and Previous are only set when there is such a page.
 type CloudBoltResult struct {
 	Links struct {
-		Self CloudBoltHALItem `json:"self"`
+		Self     CloudBoltHALItem  `json:"self"`
+		Next     *CloudBoltHALItem `json:"next,omitempty"`
+		Previous *CloudBoltHALItem `json:"previous,omitempty"`
 	} `json:"_links"`
 	Total int `json:"total"`
 	Count int `json:"count"`
@@ -75,8 +99,108 @@ type CloudBoltRunActionResult struct {
 	} `json:"results"`
 }
 
+// ErrNotFound is matched by errors.Is for any request CloudBolt answered
+// with a 404 Not Found.
+//
+// Getters such as GetGroup and GetServer used to return ErrNotFound itself.
+// They now return an *APIError that wraps it, with the status code, URL and body,
+// so comparing with err == ErrNotFound no longer matches. Use errors.Is instead:
+//
+//	if errors.Is(err, cbclient.ErrNotFound) {
+//		...
+//	}
 var ErrNotFound = errors.New("CloudBolt Object Not Found")
 
+// APIError describes a non-2xx HTTP response from CloudBolt or OneFuse.
+// Use errors.As to get at the details:
+//
+//	var apiErr *cbclient.APIError
+//	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict {
+//		...
+//	}
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	// Body is the raw response body.
+	Body []byte
+	// Message is the error message parsed out of Body, if there was one.
+	Message string
+	// Details holds the OneFuse error details, if the response contained any.
+	Details *OneFuseErrorDetails
+}
+
+// OneFuseErrorDetails is the error payload attached to failed OneFuse requests and jobs.
+type OneFuseErrorDetails struct {
+	Code   int                    `json:"code,omitempty"`
+	Errors *[]OneFuseErrorMessage `json:"errors,omitempty"`
+}
+
+type OneFuseErrorMessage struct {
+	Message string `json:"message,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	kind := "an HTTP client error"
+	if e.StatusCode >= 500 {
+		kind = "a server error"
+	}
+
+	msg := e.Message
+	if msg == "" {
+		msg = string(bytes.TrimSpace(e.Body))
+	}
+
+	return fmt.Sprintf("received %s: %d %s %s: %s", kind, e.StatusCode, e.Method, e.URL, msg)
+}
+
+// Unwrap lets errors.Is(err, ErrNotFound) match 404 responses.
+func (e *APIError) Unwrap() error {
+	if e.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Messages returns the individual error messages in the details.
+func (d *OneFuseErrorDetails) Messages() []string {
+	if d == nil || d.Errors == nil {
+		return nil
+	}
+
+	messages := make([]string, 0, len(*d.Errors))
+	for _, e := range *d.Errors {
+		messages = append(messages, e.Message)
+	}
+
+	return messages
+}
+
+// NewClient returns a CloudBoltClient for the CloudBolt install at baseURL,
+// e.g., "https://cloudbolt.intranet" or "https://cloudbolt.intranet:8443".
+// A path in baseURL, e.g., "https://proxy.intranet/cloudbolt/", is used as a prefix
+// for every request, for installs behind a reverse proxy.
+//
+// Returns an error if baseURL is not an absolute http(s) URL or an Option fails.
+// Without WithCredentials or WithTokenProvider requests are sent unauthenticated.
+//
+// NewClient does not make any API calls.
+func NewClient(baseURL string, opts ...Option) (*CloudBoltClient, error) {
+	parsedURL, pathPrefix, err := parseBaseURL(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newClient(parsedURL, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.pathPrefix = pathPrefix
+
+	return c, nil
+}
+
 // New returns an initialized CloudBoltClient object.
 // Accepts as input:
 // - HTTP Protocol (protocol) e.g., "https"
@@ -87,40 +211,83 @@ var ErrNotFound = errors.New("CloudBolt Object Not Found")
 // - Domain (domain) e.g., "mydomain.com"
 // - User-provided *HTTPClient (httpClient); provide `nil` to get a server with the following defaults:
 //   - Timeout set to 60 seconds
-//   Provide a custom http.Client if you require unique certificate, timeout, etc., configured.
+//     Provide a custom http.Client if you require unique certificate, timeout, etc., configured.
+//
+// New does not validate its arguments; NewClient does, and takes more options.
 //
 // New does not make any API calls.
 // CloudBoltClient.Authenticate must be called to initialize CloudBoltClient.token.
-// This is done automatically when a request receives an HTTP Authorization error.
+// This is done automatically when a request receives an HTTP Authorization error,
+// or before the first request if SetTokenRefresh is used.
+// Use SetTokenProvider to authenticate with something other than a password.
 func New(protocol string, host string, port string, username string, password string, domain string, httpClient *http.Client) *CloudBoltClient {
 	baseURL := url.URL{
 		Scheme: protocol,
 		Host:   fmt.Sprintf("%s:%s", host, port),
 	}
 
-	var client *http.Client
-	if httpClient == nil {
-		// The given Client is `nil` so we provide a sane default
-		client = &http.Client{
-			Timeout: 60 * time.Second,
+	// None of these options can fail
+	c, _ := newClient(baseURL, WithCredentials(username, password), WithDomain(domain), WithHTTPClient(httpClient))
+
+	return c
+}
+
+// newClient applies opts to a new CloudBoltClient for baseURL.
+func newClient(baseURL url.URL, opts ...Option) (*CloudBoltClient, error) {
+	o := &clientOptions{
+		userAgent: defaultUserAgent,
+	}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
 		}
-	} else {
-		// The user provided an HTTP Client so we pass that through to the CloudBoltClient
-		client = httpClient
 	}
 
-	return &CloudBoltClient{
-		baseURL:    baseURL,
-		httpClient: client,
-		username:   username,
-		password:   password,
-		domain:     domain,
+	httpClient, err := o.buildHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &CloudBoltClient{
+		baseURL:     baseURL,
+		httpClient:  httpClient,
+		userAgent:   o.userAgent,
+		logger:      nopLogger{},
+		retryPolicy: o.retryPolicy,
+	}
+	c.SetLogger(o.logger)
+
+	if o.tokenProvider != nil {
+		c.SetTokenProvider(o.tokenProvider)
+	} else if o.username != "" || o.password != "" {
+		c.SetCredentials(o.username, o.password, o.domain)
 	}
+
+	if o.refresh != nil {
+		c.SetTokenRefresh(*o.refresh)
+	}
+
+	return c, nil
 }
 
-// Authenticate forces the CloudBoltClient to re-authenticate
+// Authenticate forces the CloudBoltClient to get a new token from its TokenProvider.
 // Returns an error if there is an HTTP error, or if the HTTP Status Code is >=400
 func (c *CloudBoltClient) Authenticate() (int, error) {
+	return c.AuthenticateWithContext(context.Background())
+}
+
+// AuthenticateWithContext is the same as Authenticate with a caller-provided context.
+// Cancelling ctx aborts the token request.
+//
+// If another goroutine is already authenticating, this waits for and returns its result
+// rather than logging in a second time.
+func (c *CloudBoltClient) AuthenticateWithContext(ctx context.Context) (int, error) {
+	return c.authenticate(ctx, nil)
+}
+
+// requestToken logs in with the client's credentials and returns the new API token.
+// It does not touch c.token; see authenticate.
+func (c *CloudBoltClient) requestToken(ctx context.Context) (int, string, error) {
 	// Craft the JSON payload used to request an API token
 	var reqJSON []byte
 	var err error
@@ -138,7 +305,7 @@ func (c *CloudBoltClient) Authenticate() (int, error) {
 
 		reqJSON, err = json.Marshal(userCreds)
 		if err != nil {
-			return -1, err
+			return -1, "", err
 		}
 	} else {
 		userCreds := struct {
@@ -151,7 +318,7 @@ func (c *CloudBoltClient) Authenticate() (int, error) {
 
 		reqJSON, err = json.Marshal(userCreds)
 		if err != nil {
-			return -1, err
+			return -1, "", err
 		}
 	}
 
@@ -160,24 +327,29 @@ func (c *CloudBoltClient) Authenticate() (int, error) {
 
 	// Craft the URL api-token request endpoint based on the API version
 	apiurl := c.baseURL
-	apiurl.Path = c.apiEndpoint("cmp", "apiToken")
+	apiurl.Path = c.pathPrefix + c.apiEndpoint("cmp", "apiToken")
 
 	// Make the POST request to get the API token
-	req, err := http.NewRequest("POST", apiurl.String(), reqJSONBuffer)
+	req, err := http.NewRequestWithContext(ctx, "POST", apiurl.String(), reqJSONBuffer)
 	if err != nil {
-		return -1, err
+		return -1, "", err
 	}
 	req.Header.Set("Content-Type", "application/json")
 
+	c.log().Info("cbclient: authenticating", "username", c.username, "domain", c.domain)
+
 	// Execute the HTTP request
-	resp, err := c.httpClient.Do(req)
+	// Requesting a token has no side effects, so it is always safe to retry
+	resp, err := c.doRequest(req, true)
 	if err != nil {
-		return -1, fmt.Errorf("Failed to create the API client. %s", err)
+		return -1, "", fmt.Errorf("Failed to create the API client. %w", err)
 	}
 
+	defer resp.Body.Close()
+
 	// We received a bad HTTP request, so forward that to the caller before trying to parse the response
 	if resp.StatusCode >= 400 {
-		return resp.StatusCode, fmt.Errorf("Received bad HTTP response %d: %s", resp.StatusCode, resp.Status)
+		return resp.StatusCode, "", newAPIError(resp)
 	}
 
 	// We Decode the data because we already have an io.Reader on hand
@@ -187,11 +359,8 @@ func (c *CloudBoltClient) Authenticate() (int, error) {
 
 	json.NewDecoder(resp.Body).Decode(&userAuthData)
 
-	// Set the CloudBoltClient token as that parsed Token value
-	c.token = userAuthData.Token
-
-	// Return the HTTP status code and a nil error for success
-	return resp.StatusCode, nil
+	// Return the HTTP status code, the parsed Token value and a nil error for success
+	return resp.StatusCode, userAuthData.Token, nil
 }
 
 // apiEndpoint standardizes getting a CloudBolt API endpoint
@@ -221,11 +390,16 @@ func (c *CloudBoltClient) apiEndpoint(paths ...string) string {
 	return fmt.Sprintf("/%s/", formattedPath)
 }
 
-// makeRequest wraps the normal HTTP request by re-authenticating if we get an
+// authWrappedRequest wraps the normal HTTP request by re-authenticating if we get an
 // "Unauthorized" HTTP response.
 //
-// if the first attempt at the request returns a 401 or 403 HTTP Status Code
+// if the first attempt at the request is rejected for authentication (see isAuthFailure)
 // it Attempts exactly one call to CloudBoltClient.Authenticate() and resets the request token.
+// Every other response, successful or not, is returned untouched, so a request that
+// failed for any other reason is never sent twice.
+//
+// The re-authentication uses the context of req, so cancelling it aborts
+// the original request, the token request and the replayed request alike.
 func (c *CloudBoltClient) authWrappedRequest(req *http.Request, backup *http.Request) (*http.Response, error) {
 	// if c.token == "" {
 	// 	log.Printf("Authenticating %+v", req)
@@ -237,24 +411,33 @@ func (c *CloudBoltClient) authWrappedRequest(req *http.Request, backup *http.Req
 	// }
 
 	// Add the Auth token to the request
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	token, err := c.tokenForRequest(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 
 	// Attempt to make the given HTTP request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req, isIdempotent(req.Method))
 	if err != nil {
 		return nil, err
 	}
 
-	// (Bluntly) Handles common HTTP "auth" related Status Codes
-	if resp.StatusCode >= 400 {
-		_, err := c.Authenticate()
+	// Only re-authenticate when the token was the problem
+	if isAuthFailure(resp) {
+		c.log().Warn("cbclient: re-authenticating", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode)
+
+		resp.Body.Close()
+
+		// Requests that failed with the same token share one authentication
+		_, err := c.authenticate(req.Context(), &token)
 		if err != nil {
 			return nil, err
 		}
 
-		backup.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+		backup.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.currentToken()))
 
-		resp, err := c.httpClient.Do(backup)
+		resp, err := c.doRequest(backup, isIdempotent(backup.Method))
 		if err != nil {
 			return nil, err
 		}
@@ -262,19 +445,53 @@ func (c *CloudBoltClient) authWrappedRequest(req *http.Request, backup *http.Req
 		return resp, nil
 	}
 
-	// If we didn't get one of the those 50x/40x Status Codes,
+	// If the token wasn't the problem,
 	// then pass through the original result
 	// Return the original response
 	return resp, nil
 }
 
+// isAuthFailure reports whether resp means the request needs a new token:
+// - 401 Unauthorized, always.
+// - 403 Forbidden, when the body says the token expired or is invalid.
+//
+// Any other 403 is a real permission error and is left for the caller,
+// even if the request was sent without a token.
+// The body of resp is read to check a 403 and then restored.
+func isAuthFailure(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		return true
+	case http.StatusForbidden:
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		msg := strings.ToLower(string(body))
+		for _, s := range []string{"expired", "invalid token", "token is invalid", "invalid signature"} {
+			if strings.Contains(msg, s) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // makeRequest wraps what http.NewRequest would do:
 // Creates an HTTP request
 // Creates a duplicate if the body is not nil
 // Calls authWrappedRequest with both requests
-func (c *CloudBoltClient) makeRequest(method string, url string, body []byte) (*http.Response, error) {
+//
+// Both requests are bound to ctx.
+func (c *CloudBoltClient) makeRequest(ctx context.Context, method string, url string, body []byte) (*http.Response, error) {
+	url, err := c.prefixURL(url)
+	if err != nil {
+		return nil, err
+	}
+
 	// Construct the initial request
-	req, err := constructRequest(method, url, body)
+	req, err := constructRequest(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -285,7 +502,7 @@ func (c *CloudBoltClient) makeRequest(method string, url string, body []byte) (*
 	// If the Body is not nil, we cannot reuse the request object
 	// So we generate a new request object from scratch
 	if body != nil {
-		reqBackup, err = constructRequest(method, url, body)
+		reqBackup, err = constructRequest(ctx, method, url, body)
 		if err != nil {
 			return nil, err
 		}
@@ -294,16 +511,35 @@ func (c *CloudBoltClient) makeRequest(method string, url string, body []byte) (*
 	return c.authWrappedRequest(req, reqBackup)
 }
 
+// prefixURL adds the client's path prefix to the path of rawURL.
+// Paths that already start with the prefix, like hrefs from a proxy that rewrites them, are left alone.
+func (c *CloudBoltClient) prefixURL(rawURL string) (string, error) {
+	if c.pathPrefix == "" {
+		return rawURL, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	if u.Path != c.pathPrefix && !strings.HasPrefix(u.Path, c.pathPrefix+"/") {
+		u.Path = c.pathPrefix + u.Path
+	}
+
+	return u.String(), nil
+}
+
 // constructRequest generates a CloudBolt API HTTP request object.
 // - Reads the body into a buffer.
-// - Calls http.NewRequest
+// - Calls http.NewRequestWithContext
 // - Sets ContentType and Accept to JSON
-func constructRequest(method string, url string, body []byte) (*http.Request, error) {
+func constructRequest(ctx context.Context, method string, url string, body []byte) (*http.Request, error) {
 	// Load the body into a buffer
 	reqBody := bytes.NewBuffer(body)
 
 	// Generate a new HTTP Request
-	req, err := http.NewRequest(method, url, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -315,23 +551,73 @@ func constructRequest(method string, url string, body []byte) (*http.Request, er
 	return req, nil
 }
 
+// checkHttpStatus returns an *APIError for any 4xx or 5xx response.
 func checkHttpStatus(resp *http.Response) error {
-	switch {
-	case resp.StatusCode >= 500:
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
-		respBody := buf.String()
-		return fmt.Errorf("received a server error: %s", respBody)
-	case resp.StatusCode >= 400:
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
-		respBody := buf.String()
-		return fmt.Errorf("received an HTTP client error: %s", respBody)
+	if resp.StatusCode >= 400 {
+		return newAPIError(resp)
 	}
 
 	return nil
 }
 
+// decodeIfPresent decodes the JSON body of a successful response into out.
+// It reports false if the body is empty or can't be decoded into out, in which case out may be partly filled in.
+// Actions use it so a response they can't read isn't mistaken for a failed action.
+func decodeIfPresent(body io.Reader, out interface{}) bool {
+	data, err := io.ReadAll(body)
+	if err != nil || len(bytes.TrimSpace(data)) == 0 {
+		return false
+	}
+
+	return json.Unmarshal(data, out) == nil
+}
+
+// newAPIError reads the body of a failed response and parses whatever
+// error message CloudBolt or OneFuse put in it.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+	}
+
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.URL = resp.Request.URL.String()
+	}
+
+	buf := new(bytes.Buffer)
+	buf.ReadFrom(resp.Body)
+	apiErr.Body = buf.Bytes()
+
+	// CloudBolt sends {"detail": "..."} or {"error": "..."}, OneFuse sends
+	// {"code": 400, "errors": [...]} or a job status with "errorDetails".
+	var body map[string]json.RawMessage
+	if json.Unmarshal(apiErr.Body, &body) != nil {
+		return apiErr
+	}
+
+	var details OneFuseErrorDetails
+	if raw, ok := body["errorDetails"]; ok && json.Unmarshal(raw, &details) == nil && details.Errors != nil {
+		apiErr.Details = &details
+	} else if raw, ok := body["errors"]; ok && json.Unmarshal(raw, &details.Errors) == nil {
+		json.Unmarshal(body["code"], &details.Code)
+		apiErr.Details = &details
+	}
+
+	for _, key := range []string{"detail", "message", "error"} {
+		var msg string
+		if json.Unmarshal(body[key], &msg) == nil && msg != "" {
+			apiErr.Message = msg
+			break
+		}
+	}
+
+	if apiErr.Message == "" && apiErr.Details != nil {
+		apiErr.Message = strings.Join(apiErr.Details.Messages(), "; ")
+	}
+
+	return apiErr
+}
+
 func checkOneFuseResponse(resp *http.Response) (*OneFuseJobStatus, error) {
 	err := checkHttpStatus(resp)
 	if err != nil {
@@ -1,6 +1,8 @@
 package cbclient
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/url"
 	"testing"
@@ -129,7 +131,7 @@ func TestAuthWrappedRequest(t *testing.T) {
 	reqBody := []byte(`{"bar": "foo"}`)
 
 	// Call authWrappedRequest implicitly via makeRequest
-	resp, err := client.makeRequest("POST", apiurl.String(), reqBody)
+	resp, err := client.makeRequest(context.Background(), "POST", apiurl.String(), reqBody)
 	Expect(err).NotTo(HaveOccurred())
 	Expect(resp).NotTo(BeNil())
 
@@ -198,6 +200,650 @@ func TestAuthWrappedRequestWithValidToken(t *testing.T) {
 	Expect(body).To(MatchJSON(`{"foo": "bar"}`))
 }
 
+// Responses that aren't authentication failures are handed back untouched,
+// without fetching a token or replaying the request.
+func TestAuthWrappedRequestNonAuthErrors(t *testing.T) {
+	responses := map[int]string{
+		http.StatusBadRequest:          aBadRequestResponseBody,
+		http.StatusForbidden:           aForbiddenResponseBody,
+		http.StatusNotFound:            aNotFoundResponseBody,
+		http.StatusConflict:            aConflictResponseBody,
+		http.StatusInternalServerError: `{"detail": "A server error occurred."}`,
+	}
+
+	for status, responseBody := range responses {
+		status, responseBody := status, responseBody
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			// Register the test with gomega
+			RegisterTestingT(t)
+
+			server, requests := mockServer(func(i int) (string, int) {
+				return responseBody, status
+			})
+			Expect(server).NotTo(BeNil())
+			Expect(requests).NotTo(BeNil())
+			defer server.Close()
+
+			client := getClient(server)
+			Expect(client).NotTo(BeNil())
+			client.token = "Testing Token"
+
+			apiurl := client.baseURL
+			apiurl.Path = "/foo/"
+
+			resp, err := client.makeRequest(context.Background(), "POST", apiurl.String(), []byte(`{"foo": "bar"}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).NotTo(BeNil())
+
+			// Exactly one request, and the response is the original one
+			Expect(len(*requests)).To(Equal(1))
+			Expect(resp.StatusCode).To(Equal(status))
+			Expect(bodyToString(resp.Body)).To(MatchJSON(responseBody))
+			Expect(client.token).To(Equal("Testing Token"))
+		})
+	}
+}
+
+// A permission denial is handed back as is, even when the client has no token yet.
+func TestAuthWrappedRequestForbiddenWithoutToken(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(func(i int) (string, int) {
+		return aForbiddenResponseBody, http.StatusForbidden
+	})
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.token = ""
+
+	apiurl := client.baseURL
+	apiurl.Path = "/foo/"
+
+	resp, err := client.makeRequest(context.Background(), "GET", apiurl.String(), nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp).NotTo(BeNil())
+	Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+
+	// No token request, no replay
+	Expect(len(*requests)).To(Equal(1))
+	Expect((*requests)[0].URL.Path).To(Equal("/foo/"))
+}
+
+// A 403 saying the token expired is treated like a 401.
+func TestAuthWrappedRequestExpiredToken(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForExpiredToken)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.token = "Expired Token"
+
+	apiurl := client.baseURL
+	apiurl.Path = "/foo/"
+
+	resp, err := client.makeRequest(context.Background(), "GET", apiurl.String(), nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp).NotTo(BeNil())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[0].Header.Get("Authorization")).To(Equal("Bearer Expired Token"))
+	Expect((*requests)[1].URL.Path).To(Equal("/api/v3/cmp/apiToken/"))
+	Expect((*requests)[2].Header.Get("Authorization")).To(Equal("Bearer Testing Token"))
+}
+
+// A rejected order must not be submitted a second time.
+func TestDeployBlueprintNotResentOnBadRequest(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForDeployBlueprintBadRequest)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	order, err := client.DeployBlueprint("/api/v3/cmp/groups/GRP-1/", "BP-esnjtp7u", "", nil, nil)
+	Expect(order).To(BeNil())
+	Expect(err).To(HaveOccurred())
+
+	var apiErr *APIError
+	Expect(errors.As(err, &apiErr)).To(BeTrue())
+	Expect(apiErr.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// 1+2. The first attempt is unauthorized, get a token
+	// 3. The order is rejected, and that's the end of it
+	Expect(len(*requests)).To(Equal(3))
+
+	Expect((*requests)[1].URL.Path).To(Equal("/api/v3/cmp/apiToken/"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/blueprints/BP-esnjtp7u/deploy/"))
+
+	// Once authenticated, a 400 is a single POST and nothing else
+	_, err = client.DeployBlueprint("/api/v3/cmp/groups/GRP-1/", "BP-esnjtp7u", "", nil, nil)
+	Expect(err).To(HaveOccurred())
+	Expect(errors.As(err, &apiErr)).To(BeTrue())
+	Expect(apiErr.StatusCode).To(Equal(http.StatusBadRequest))
+
+	Expect(len(*requests)).To(Equal(4))
+	Expect((*requests)[3].Method).To(Equal("POST"))
+	Expect((*requests)[3].URL.Path).To(Equal("/api/v3/cmp/blueprints/BP-esnjtp7u/deploy/"))
+}
+
+// A context that is already cancelled must abort the request before anything
+// reaches the server.
+func TestMakeRequestWithCanceledContext(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Create server, requests, and client.
+	server, requests := mockServer(responsesForAuthWrappedRequest)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	apiurl := client.baseURL
+	apiurl.Path = "/foo/"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	resp, err := client.makeRequest(ctx, "GET", apiurl.String(), nil)
+	Expect(resp).To(BeNil())
+	Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+
+	// Nothing should have been sent
+	Expect(len(*requests)).To(Equal(0))
+}
+
+// When the context is cancelled after the first attempt was rejected, the
+// re-authentication and the replayed request must not be sent.
+func TestAuthWrappedRequestCanceledBeforeReauth(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Cancel the context while serving the first (unauthorized) response
+	server, requests := mockServer(func(i int) (string, int) {
+		if i == 0 {
+			cancel()
+		}
+		return responsesForAuthWrappedRequest(i)
+	})
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	apiurl := client.baseURL
+	apiurl.Path = "/foo/"
+
+	resp, err := client.makeRequest(ctx, "POST", apiurl.String(), []byte(`{"bar": "foo"}`))
+	Expect(resp).To(BeNil())
+	Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+
+	// Only the original request reached the server
+	Expect(len(*requests)).To(Equal(1))
+	Expect((*requests)[0].URL.Path).To(Equal("/foo/"))
+	Expect(client.token).To(BeEmpty())
+}
+
+func TestGetBlueprintWithContextDeadline(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForBlueprint)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	blueprint, err := client.GetBlueprintWithContext(ctx, "My Simple Blueprint")
+	Expect(blueprint).To(BeNil())
+	Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	Expect(len(*requests)).To(Equal(0))
+}
+
+// clientGetters calls every read-only CloudBoltClient method with dummy arguments.
+func clientGetters() map[string]func(c *CloudBoltClient) error {
+	return map[string]func(c *CloudBoltClient) error{
+		"GetADPolicy": func(c *CloudBoltClient) error {
+			_, err := c.GetADPolicy("missing")
+			return err
+		},
+		"GetMicrosoftADComputerAccount": func(c *CloudBoltClient) error {
+			_, err := c.GetMicrosoftADComputerAccount("/api/v3/some/path/1/")
+			return err
+		},
+		"GetMicrosoftADComputerAccountById": func(c *CloudBoltClient) error {
+			_, err := c.GetMicrosoftADComputerAccountById("1")
+			return err
+		},
+		"GetMicrosoftADPolicyByID": func(c *CloudBoltClient) error {
+			_, err := c.GetMicrosoftADPolicyByID("1")
+			return err
+		},
+		"GetAnsibleTowerPolicy": func(c *CloudBoltClient) error {
+			_, err := c.GetAnsibleTowerPolicy("missing")
+			return err
+		},
+		"GetAnsibleTowerDeployment": func(c *CloudBoltClient) error {
+			_, err := c.GetAnsibleTowerDeployment("/api/v3/some/path/1/")
+			return err
+		},
+		"GetAnsibleTowerDeploymentById": func(c *CloudBoltClient) error {
+			_, err := c.GetAnsibleTowerDeploymentById("1")
+			return err
+		},
+		"GetBlueprint": func(c *CloudBoltClient) error {
+			_, err := c.GetBlueprint("missing")
+			return err
+		},
+		"GetBlueprintById": func(c *CloudBoltClient) error {
+			_, err := c.GetBlueprintById("1")
+			return err
+		},
+		"GetDNSPolicy": func(c *CloudBoltClient) error {
+			_, err := c.GetDNSPolicy("missing")
+			return err
+		},
+		"GetDNSReservation": func(c *CloudBoltClient) error {
+			_, err := c.GetDNSReservation("/api/v3/some/path/1/")
+			return err
+		},
+		"GetDNSReservationById": func(c *CloudBoltClient) error {
+			_, err := c.GetDNSReservationById("1")
+			return err
+		},
+		"GetEnvironment": func(c *CloudBoltClient) error {
+			_, err := c.GetEnvironment("missing")
+			return err
+		},
+		"GetEnvironmentById": func(c *CloudBoltClient) error {
+			_, err := c.GetEnvironmentById("1")
+			return err
+		},
+		"GetGroup": func(c *CloudBoltClient) error {
+			_, err := c.GetGroup("/api/v3/some/path/1/")
+			return err
+		},
+		"GetGroupById": func(c *CloudBoltClient) error {
+			_, err := c.GetGroupById("1")
+			return err
+		},
+		"GetIPAMPolicy": func(c *CloudBoltClient) error {
+			_, err := c.GetIPAMPolicy("missing")
+			return err
+		},
+		"GetIPAMReservation": func(c *CloudBoltClient) error {
+			_, err := c.GetIPAMReservation("/api/v3/some/path/1/")
+			return err
+		},
+		"GetIPAMReservationById": func(c *CloudBoltClient) error {
+			_, err := c.GetIPAMReservationById("1")
+			return err
+		},
+		"GetJob": func(c *CloudBoltClient) error {
+			_, err := c.GetJob("/api/v3/some/path/1/", true)
+			return err
+		},
+		"GetJobStatus": func(c *CloudBoltClient) error {
+			_, err := c.GetJobStatus("/api/v3/some/path/1/")
+			return err
+		},
+		"GetMicrosoftEndpoint": func(c *CloudBoltClient) error {
+			_, err := c.GetMicrosoftEndpoint("missing")
+			return err
+		},
+		"GetNamingPolicy": func(c *CloudBoltClient) error {
+			_, err := c.GetNamingPolicy("missing")
+			return err
+		},
+		"GetCustomName": func(c *CloudBoltClient) error {
+			_, err := c.GetCustomName("/api/v3/some/path/1/")
+			return err
+		},
+		"GetCustomNameById": func(c *CloudBoltClient) error {
+			_, err := c.GetCustomNameById("1")
+			return err
+		},
+		"GetOrder": func(c *CloudBoltClient) error {
+			_, err := c.GetOrder("1")
+			return err
+		},
+		"GetOrderStatus": func(c *CloudBoltClient) error {
+			_, err := c.GetOrderStatus("1")
+			return err
+		},
+		"GetOSBuild": func(c *CloudBoltClient) error {
+			_, err := c.GetOSBuild("missing")
+			return err
+		},
+		"GetOSBuildById": func(c *CloudBoltClient) error {
+			_, err := c.GetOSBuildById("1")
+			return err
+		},
+		"GetModulePolicy": func(c *CloudBoltClient) error {
+			_, err := c.GetModulePolicy("missing")
+			return err
+		},
+		"GetModuleDeployment": func(c *CloudBoltClient) error {
+			_, err := c.GetModuleDeployment("/api/v3/some/path/1/")
+			return err
+		},
+		"GetModuleDeploymentById": func(c *CloudBoltClient) error {
+			_, err := c.GetModuleDeploymentById("1")
+			return err
+		},
+		"GetResourceById": func(c *CloudBoltClient) error {
+			_, err := c.GetResourceById("1")
+			return err
+		},
+		"GetResourceByName": func(c *CloudBoltClient) error {
+			_, err := c.GetResourceByName("missing")
+			return err
+		},
+		"GetResource": func(c *CloudBoltClient) error {
+			_, err := c.GetResource("/api/v3/some/path/1/")
+			return err
+		},
+		"GetResourceJobInfoById": func(c *CloudBoltClient) error {
+			_, err := c.GetResourceJobInfoById("1")
+			return err
+		},
+		"GetResourceJobInfo": func(c *CloudBoltClient) error {
+			_, err := c.GetResourceJobInfo("/api/v3/some/path/1/")
+			return err
+		},
+		"GetResourceHandler": func(c *CloudBoltClient) error {
+			_, err := c.GetResourceHandler("missing")
+			return err
+		},
+		"GetResourceHandlerById": func(c *CloudBoltClient) error {
+			_, err := c.GetResourceHandlerById("1")
+			return err
+		},
+		"GetScriptingPolicy": func(c *CloudBoltClient) error {
+			_, err := c.GetScriptingPolicy("missing")
+			return err
+		},
+		"GetScriptingDeployment": func(c *CloudBoltClient) error {
+			_, err := c.GetScriptingDeployment("/api/v3/some/path/1/")
+			return err
+		},
+		"GetScriptingDeploymentById": func(c *CloudBoltClient) error {
+			_, err := c.GetScriptingDeploymentById("1")
+			return err
+		},
+		"GetServer": func(c *CloudBoltClient) error {
+			_, err := c.GetServer("/api/v3/some/path/1/")
+			return err
+		},
+		"GetServerById": func(c *CloudBoltClient) error {
+			_, err := c.GetServerById("1")
+			return err
+		},
+		"GetServerByHostname": func(c *CloudBoltClient) error {
+			_, err := c.GetServerByHostname("missing")
+			return err
+		},
+		"GetServiceNowCMDBPolicy": func(c *CloudBoltClient) error {
+			_, err := c.GetServiceNowCMDBPolicy("missing")
+			return err
+		},
+		"GetServicenowCMDBDeployment": func(c *CloudBoltClient) error {
+			_, err := c.GetServicenowCMDBDeployment("/api/v3/some/path/1/")
+			return err
+		},
+		"GetServicenowCMDBDeploymentById": func(c *CloudBoltClient) error {
+			_, err := c.GetServicenowCMDBDeploymentById("1")
+			return err
+		},
+		"GetStaticPropertySet": func(c *CloudBoltClient) error {
+			_, err := c.GetStaticPropertySet("missing")
+			return err
+		},
+		"GetVraPolicy": func(c *CloudBoltClient) error {
+			_, err := c.GetVraPolicy("missing")
+			return err
+		},
+		"GetVraDeployment": func(c *CloudBoltClient) error {
+			_, err := c.GetVraDeployment("/api/v3/some/path/1/")
+			return err
+		},
+		"GetVraDeploymentById": func(c *CloudBoltClient) error {
+			_, err := c.GetVraDeploymentById("1")
+			return err
+		},
+		"GetDefaultWorkSpace": func(c *CloudBoltClient) error {
+			_, err := c.GetDefaultWorkSpace()
+			return err
+		},
+		"GetWorkSpace": func(c *CloudBoltClient) error {
+			_, err := c.GetWorkSpace("missing")
+			return err
+		},
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForAPIError)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	_, err := client.DeployBlueprint("/api/v3/cmp/groups/GRP-yfbbsfht/", "BP-esnjtp7u", "", nil, nil)
+	Expect(err).To(HaveOccurred())
+
+	// The OneFuse style error is fully parsed
+	var apiErr *APIError
+	Expect(errors.As(err, &apiErr)).To(BeTrue())
+	Expect(apiErr.StatusCode).To(Equal(409))
+	Expect(apiErr.Method).To(Equal("POST"))
+	Expect(apiErr.URL).To(HaveSuffix("/api/v3/cmp/blueprints/BP-esnjtp7u/deploy/"))
+	Expect(apiErr.Body).To(MatchJSON(aConflictResponseBody))
+	Expect(apiErr.Message).To(Equal("Name already in use; Policy is locked"))
+	Expect(apiErr.Details.Code).To(Equal(409))
+	Expect(apiErr.Details.Messages()).To(Equal([]string{"Name already in use", "Policy is locked"}))
+	Expect(errors.Is(err, ErrNotFound)).To(BeFalse())
+	Expect(err.Error()).To(ContainSubstring("received an HTTP client error: 409 POST"))
+}
+
+func TestAPIErrorNotFound(t *testing.T) {
+	for name, getter := range clientGetters() {
+		t.Run(name, func(t *testing.T) {
+			// Register the test with gomega
+			RegisterTestingT(t)
+
+			server, requests := mockServer(responsesForNotFound)
+			Expect(server).NotTo(BeNil())
+			Expect(requests).NotTo(BeNil())
+			defer server.Close()
+
+			client := getClient(server)
+			Expect(client).NotTo(BeNil())
+
+			err := getter(client)
+			Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+
+			var apiErr *APIError
+			Expect(errors.As(err, &apiErr)).To(BeTrue())
+			Expect(apiErr.StatusCode).To(Equal(404))
+			Expect(apiErr.Method).To(Equal("GET"))
+			Expect(apiErr.Message).To(Equal("Not found."))
+		})
+	}
+}
+
+// clientMutators calls every CloudBoltClient method that creates, changes or
+// deletes something, with dummy arguments.
+func clientMutators() map[string]func(c *CloudBoltClient) error {
+	return map[string]func(c *CloudBoltClient) error{
+		"Authenticate": func(c *CloudBoltClient) error {
+			_, err := c.Authenticate()
+			return err
+		},
+		"SubmitAction": func(c *CloudBoltClient) error {
+			_, err := c.SubmitAction("/api/v3/cmp/resourceActions/RSA-1/", "/api/v3/cmp/resources/RSC-1/", nil)
+			return err
+		},
+		"DeployBlueprint": func(c *CloudBoltClient) error {
+			_, err := c.DeployBlueprint("/api/v3/cmp/groups/GRP-1/", "BP-1", "", nil, nil)
+			return err
+		},
+		"DecomServer": func(c *CloudBoltClient) error {
+			_, err := c.DecomServer("SVR-1")
+			return err
+		},
+		"RenderTemplate": func(c *CloudBoltClient) error {
+			_, err := c.RenderTemplate("{{name}}", nil)
+			return err
+		},
+		"GenerateCustomName": func(c *CloudBoltClient) error {
+			_, err := c.GenerateCustomName("1", "1", nil)
+			return err
+		},
+		"DeleteCustomName": func(c *CloudBoltClient) error {
+			_, err := c.DeleteCustomName("1")
+			return err
+		},
+		"CreateIPAMReservation": func(c *CloudBoltClient) error {
+			_, err := c.CreateIPAMReservation(&IPAMReservation{PolicyID: 1, WorkspaceURL: "/api/v3/onefuse/workspaces/1/"})
+			return err
+		},
+		"DeleteIPAMReservation": func(c *CloudBoltClient) error {
+			_, err := c.DeleteIPAMReservation("1")
+			return err
+		},
+		"CreateDNSReservation": func(c *CloudBoltClient) error {
+			_, err := c.CreateDNSReservation(&DNSReservation{PolicyID: 1, WorkspaceURL: "/api/v3/onefuse/workspaces/1/"})
+			return err
+		},
+		"DeleteDNSReservation": func(c *CloudBoltClient) error {
+			_, err := c.DeleteDNSReservation("1")
+			return err
+		},
+		"CreateMicrosoftADComputerAccount": func(c *CloudBoltClient) error {
+			_, err := c.CreateMicrosoftADComputerAccount(&MicrosoftADComputerAccount{PolicyID: 1, WorkspaceURL: "/api/v3/onefuse/workspaces/1/"})
+			return err
+		},
+		"DeleteMicrosoftADComputerAccount": func(c *CloudBoltClient) error {
+			_, err := c.DeleteMicrosoftADComputerAccount("1")
+			return err
+		},
+		"CreateMicrosoftADPolicy": func(c *CloudBoltClient) error {
+			_, err := c.CreateMicrosoftADPolicy(&MicrosoftADPolicy{WorkspaceURL: "/api/v3/onefuse/workspaces/1/"})
+			return err
+		},
+		"UpdateMicrosoftADPolicy": func(c *CloudBoltClient) error {
+			_, err := c.UpdateMicrosoftADPolicy("1", &MicrosoftADPolicy{})
+			return err
+		},
+		"DeleteMicrosoftADPolicy": func(c *CloudBoltClient) error {
+			return c.DeleteMicrosoftADPolicy("1")
+		},
+		"CreateScriptingDeployment": func(c *CloudBoltClient) error {
+			_, err := c.CreateScriptingDeployment(&ScriptingDeployment{PolicyID: 1, WorkspaceURL: "/api/v3/onefuse/workspaces/1/"})
+			return err
+		},
+		"DeleteScriptingDeployment": func(c *CloudBoltClient) error {
+			_, err := c.DeleteScriptingDeployment("1")
+			return err
+		},
+		"CreateModuleDeployment": func(c *CloudBoltClient) error {
+			_, err := c.CreateModuleDeployment(&ModuleDeployment{PolicyID: 1, WorkspaceURL: "/api/v3/onefuse/workspaces/1/"})
+			return err
+		},
+		"DeleteModuleDeployment": func(c *CloudBoltClient) error {
+			_, err := c.DeleteModuleDeployment("1")
+			return err
+		},
+		"CreateAnsibleTowerDeployment": func(c *CloudBoltClient) error {
+			_, err := c.CreateAnsibleTowerDeployment(&AnsibleTowerDeployment{PolicyID: 1, WorkspaceURL: "/api/v3/onefuse/workspaces/1/"})
+			return err
+		},
+		"DeleteAnsibleTowerDeployment": func(c *CloudBoltClient) error {
+			_, err := c.DeleteAnsibleTowerDeployment("1")
+			return err
+		},
+		"CreateVraDeployment": func(c *CloudBoltClient) error {
+			_, err := c.CreateVraDeployment(&VraDeployment{PolicyID: 1, WorkspaceURL: "/api/v3/onefuse/workspaces/1/"})
+			return err
+		},
+		"DeleteVraDeployment": func(c *CloudBoltClient) error {
+			_, err := c.DeleteVraDeployment("1")
+			return err
+		},
+		"CreateServicenowCMDBDeployment": func(c *CloudBoltClient) error {
+			_, err := c.CreateServicenowCMDBDeployment(&ServicenowCMDBDeployment{PolicyID: 1, WorkspaceURL: "/api/v3/onefuse/workspaces/1/"})
+			return err
+		},
+		"DeleteServicenowCMDBDeployment": func(c *CloudBoltClient) error {
+			_, err := c.DeleteServicenowCMDBDeployment("1")
+			return err
+		},
+	}
+}
+
+// TestConnectionFailure makes sure a dropped connection comes back to the
+// caller as an error. Any method that still called log.Fatalln would exit the
+// test binary here instead of failing a single subtest.
+func TestConnectionFailure(t *testing.T) {
+	methods := clientGetters()
+	for name, method := range clientMutators() {
+		methods[name] = method
+	}
+
+	for name, method := range methods {
+		t.Run(name, func(t *testing.T) {
+			// Register the test with gomega
+			RegisterTestingT(t)
+
+			server, requests := mockBrokenServer()
+			Expect(server).NotTo(BeNil())
+			Expect(requests).NotTo(BeNil())
+			defer server.Close()
+
+			client := getClient(server)
+			Expect(client).NotTo(BeNil())
+
+			err := method(client)
+			Expect(err).To(HaveOccurred())
+
+			// The error is the transport failure, not an HTTP status
+			var apiErr *APIError
+			Expect(errors.As(err, &apiErr)).To(BeFalse())
+
+			// Exactly one request was attempted before giving up
+			Expect(len(*requests)).To(Equal(1))
+		})
+	}
+}
+
 func TestAPIEndpoint(t *testing.T) {
 	RegisterTestingT(t)
 
@@ -0,0 +1,140 @@
+package cbclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// authCall is an authentication in progress.
+// Requests that need a new token while one is being fetched wait on done
+// and share its result instead of logging in again.
+type authCall struct {
+	done   chan struct{}
+	status int
+	err    error
+}
+
+// currentToken returns the API token requests should be sent with.
+func (c *CloudBoltClient) currentToken() string {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	return c.token
+}
+
+// tokenForRequest returns the token to send a request with.
+// With proactive refresh on, a missing or nearly expired token is replaced first.
+func (c *CloudBoltClient) tokenForRequest(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	token := c.token
+	stale := c.proactiveRefresh && (token == "" || (!c.tokenExpiry.IsZero() && time.Now().Add(c.refreshWindow).After(c.tokenExpiry)))
+	c.tokenMu.Unlock()
+
+	if stale {
+		c.log().Debug("cbclient: refreshing token before request")
+
+		if _, err := c.authenticate(ctx, &token); err != nil {
+			return "", err
+		}
+		token = c.currentToken()
+	}
+
+	return token, nil
+}
+
+// authenticate fetches a new API token from the TokenProvider, or waits for the one already being fetched.
+//
+// staleToken is the token a request was rejected with. If the client has already
+// replaced it by the time we get here, there's nothing to do and no request is made.
+// Pass nil to always authenticate.
+//
+// Only the goroutine that starts an authentication uses its own ctx for the token request.
+// If that ctx is cancelled, goroutines still waiting with a live ctx start over.
+func (c *CloudBoltClient) authenticate(ctx context.Context, staleToken *string) (int, error) {
+	for {
+		c.tokenMu.Lock()
+
+		if call := c.authCall; call != nil {
+			c.tokenMu.Unlock()
+
+			select {
+			case <-call.done:
+			case <-ctx.Done():
+				return -1, ctx.Err()
+			}
+
+			if isContextError(call.err) && ctx.Err() == nil {
+				continue
+			}
+
+			return call.status, call.err
+		}
+
+		if staleToken != nil && *staleToken != c.token {
+			c.tokenMu.Unlock()
+			return 0, nil
+		}
+
+		call := &authCall{done: make(chan struct{})}
+		c.authCall = call
+		c.tokenMu.Unlock()
+
+		token, err := c.fetchToken(ctx)
+		call.status, call.err = authStatus(err), err
+
+		c.tokenMu.Lock()
+		if err == nil {
+			c.token = token.Value
+			c.tokenExpiry = token.Expiry
+		}
+		c.authCall = nil
+		c.tokenMu.Unlock()
+
+		close(call.done)
+
+		return call.status, call.err
+	}
+}
+
+// fetchToken asks the TokenProvider for a new token.
+func (c *CloudBoltClient) fetchToken(ctx context.Context) (*Token, error) {
+	c.tokenMu.Lock()
+	provider := c.tokenProvider
+	c.tokenMu.Unlock()
+
+	if provider == nil {
+		return nil, errors.New("no credentials or TokenProvider configured")
+	}
+
+	token, err := provider.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, errNoToken
+	}
+
+	return token, nil
+}
+
+// authStatus is the HTTP status Authenticate reports for the result of fetchToken:
+// 200 on success, the status of the token request if it failed with one, -1 otherwise.
+func authStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+
+	return -1
+}
+
+// isContextError reports whether err came from a cancelled or expired context.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
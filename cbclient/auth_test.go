@@ -0,0 +1,118 @@
+package cbclient
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// Run with -race: many goroutines share one client that has no token yet.
+// They all get a 401, but only one of them logs in and the rest reuse its token.
+func TestConcurrentRequestsShareAuthentication(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, logins := mockAuthServer(aBlueprint, 50*time.Millisecond)
+	Expect(server).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	const workers = 20
+	errs := make(chan error, workers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			blueprint, err := client.GetBlueprintById("BP-esnjtp7u")
+			if err == nil && blueprint.ID != "BP-esnjtp7u" {
+				t.Errorf("unexpected blueprint %q", blueprint.ID)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Expect(atomic.LoadInt32(logins)).To(Equal(int32(1)))
+	Expect(client.currentToken()).To(Equal("Testing Token"))
+}
+
+// Explicit calls to Authenticate that overlap also share one login.
+func TestConcurrentAuthenticate(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, logins := mockAuthServer(aBlueprint, 50*time.Millisecond)
+	Expect(server).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			status, err := client.Authenticate()
+			if err != nil || status != 200 {
+				t.Errorf("Authenticate() = %d, %v", status, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	Expect(atomic.LoadInt32(logins)).To(Equal(int32(1)))
+
+	// Once the first login is over, a new call logs in again
+	_, err := client.Authenticate()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(atomic.LoadInt32(logins)).To(Equal(int32(2)))
+}
+
+// A waiting request gives up when its own context is done,
+// without affecting the login in progress.
+func TestAuthenticateWaiterCanceled(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, logins := mockAuthServer(aBlueprint, 200*time.Millisecond)
+	Expect(server).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	done := make(chan error)
+	go func() {
+		_, err := client.Authenticate()
+		done <- err
+	}()
+
+	// Wait for the first login to be in flight
+	Eventually(func() int32 { return atomic.LoadInt32(logins) }).Should(Equal(int32(1)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := client.AuthenticateWithContext(ctx)
+	Expect(err).To(MatchError(context.DeadlineExceeded))
+
+	Expect(<-done).NotTo(HaveOccurred())
+	Expect(atomic.LoadInt32(logins)).To(Equal(int32(1)))
+	Expect(client.currentToken()).To(Equal("Testing Token"))
+}
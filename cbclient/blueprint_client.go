@@ -1,10 +1,9 @@
 package cbclient
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 type CloudBoltBlueprintResult struct {
@@ -14,19 +13,115 @@ type CloudBoltBlueprintResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the Blueprints on this page of results.
+func (r *CloudBoltBlueprintResult) Items() []CloudBoltReferenceFields {
+	return r.Embedded.Blueprints
+}
+
+// CloudBoltBlueprint is everything CloudBolt has to say about a Blueprint,
+// including what it takes to deploy it. See DescribeBlueprint.
+type CloudBoltBlueprint struct {
+	Links struct {
+		Self                CloudBoltHALItem   `json:"self"`
+		Deploy              CloudBoltHALItem   `json:"deploy"`
+		DeploymentSchema    CloudBoltHALItem   `json:"deploymentSchema"`
+		SamplePayload       CloudBoltHALItem   `json:"samplePayload"`
+		GroupsThatCanManage []CloudBoltHALItem `json:"groupsThatCanManage"`
+		GroupsThatCanDeploy []CloudBoltHALItem `json:"groupsThatCanDeploy"`
+		ResourceType        CloudBoltHALItem   `json:"resourceType"`
+	} `json:"_links"`
+	Name                 string `json:"name"`
+	ID                   string `json:"id"`
+	Description          string `json:"description"`
+	AnyGroupCanDeploy    bool   `json:"anyGroupCanDeploy"`
+	IsOrderable          bool   `json:"isOrderable"`
+	IsManageable         bool   `json:"isManageable"`
+	ResourceNameTemplate string `json:"resourceNameTemplate"`
+	Status               string `json:"status"`
+	Labels               []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	DeploymentItems []BlueprintItem `json:"deploymentItems"`
+	// Schema describes the parameters, environments and OS Builds the Blueprint accepts.
+	Schema *BlueprintDeploymentSchema `json:"-"`
+}
+
+// BlueprintItem is one of the build items of a Blueprint.
+// Which fields are set depends on TierType, e.g., HostnameTemplate for "server", ActionName for "plugin".
+type BlueprintItem struct {
+	ID                     string   `json:"id"`
+	Name                   string   `json:"name"`
+	Description            string   `json:"description"`
+	TierType               string   `json:"tierType"`
+	DeploySeq              int      `json:"deploySeq"`
+	ExecuteInParallel      bool     `json:"executeInParallel"`
+	ShowOnOrderForm        bool     `json:"showOnOrderForm"`
+	HostnameTemplate       string   `json:"hostnameTemplate"`
+	AllEnvironmentsEnabled bool     `json:"allEnvironmentsEnabled"`
+	AllowedOSFamilies      []string `json:"allowedOsFamilies"`
+	ActionName             string   `json:"actionName"`
+	ContinueOnFailure      bool     `json:"continueOnFailure"`
+	RunOnScaleUp           bool     `json:"runOnScaleUp"`
+}
+
+// BlueprintDeploymentSchema is what a Blueprint accepts in a DeploymentRequest,
+// as returned by /api/v3/cmp/blueprints/<id>/deploymentSchema/.
+type BlueprintDeploymentSchema struct {
+	// Parameters are the Blueprint-level parameters, by name.
+	Parameters map[string]BlueprintParameter `json:"parameters"`
+	// DeploymentItems are the build items, by the name they're given in a DeploymentRequest,
+	// e.g., "server-bdi-743tlxxu".
+	DeploymentItems map[string]BlueprintItemSchema `json:"deploymentItems"`
+}
+
+// BlueprintItemSchema is what a build item accepts in a DeploymentRequest.
+type BlueprintItemSchema struct {
+	TierType string `json:"tierType"`
+	// Environments the item can be deployed to. Empty means the item doesn't take one.
+	Environments []CloudBoltHALItem `json:"environments"`
+	// OSBuilds the item can use. Empty means the item doesn't take one.
+	OSBuilds   []CloudBoltHALItem            `json:"osBuilds"`
+	Parameters map[string]BlueprintParameter `json:"parameters"`
+}
+
+// BlueprintParameter describes a parameter of a Blueprint or build item.
+type BlueprintParameter struct {
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	// Type is the CloudBolt field type, e.g., "STR", "INT", "DEC" or "BOOL".
+	Type     string      `json:"type"`
+	Required bool        `json:"required"`
+	Default  interface{} `json:"default"`
+	// Options are the allowed values. Empty means any value of the right type.
+	Options []interface{} `json:"options"`
+}
+
 // GetBlueprint accepts the name of a Blueprint
-//
-func (c *CloudBoltClient) GetBlueprint(name string) (*CloudBoltReferenceFields, error) {
+func (c *CloudBoltClient) GetBlueprint(name string, filters ...*Filter) (*CloudBoltReferenceFields, error) {
+	return c.GetBlueprintWithContext(context.Background(), name, filters...)
+}
+
+// GetBlueprintWithContext is the same as GetBlueprint with a caller-provided context.
+func (c *CloudBoltClient) GetBlueprintWithContext(ctx context.Context, name string, filters ...*Filter) (*CloudBoltReferenceFields, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "blueprints")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	// We Decode the data because we already have an io.Reader on hand
 	var res CloudBoltBlueprintResult
@@ -43,15 +138,24 @@ func (c *CloudBoltClient) GetBlueprint(name string) (*CloudBoltReferenceFields,
 }
 
 func (c *CloudBoltClient) GetBlueprintById(id string) (*CloudBoltReferenceFields, error) {
+	return c.GetBlueprintByIdWithContext(context.Background(), id)
+}
+
+// GetBlueprintByIdWithContext is the same as GetBlueprintById with a caller-provided context.
+func (c *CloudBoltClient) GetBlueprintByIdWithContext(ctx context.Context, id string) (*CloudBoltReferenceFields, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "blueprints", id)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	// We Decode the data because we already have an io.Reader on hand
 	var res CloudBoltReferenceFields
@@ -62,64 +166,87 @@ func (c *CloudBoltClient) GetBlueprintById(id string) (*CloudBoltReferenceFields
 	return &res, nil
 }
 
-func (c *CloudBoltClient) DeployBlueprint(grpPath string, blueprintID string, resourceName string, bpParams map[string]interface{}, bpItems []map[string]interface{}) (*CloudBoltOrder, error) {
-	deployItems := make(map[string]interface{})
-
-	for _, v := range bpItems {
-		deployItems[v["bp-item-name"].(string)] = map[string]interface{}{
-			"parameters": v["bp-item-paramas"].(map[string]interface{}),
-		}
-
-		env, ok := v["environment"]
-		if ok {
-			deployItems[v["bp-item-name"].(string)].(map[string]interface{})["environment"] = env
-		}
-
-		osb, ok := v["osbuild"]
-		if ok {
-			deployItems[v["bp-item-name"].(string)].(map[string]interface{})["osBuild"] = osb
-		}
-	}
+// DescribeBlueprint fetches the Blueprint with the given ID, e.g., "BP-esnjtp7u",
+// along with its deployment schema: its parameters and what each build item accepts.
+func (c *CloudBoltClient) DescribeBlueprint(id string) (*CloudBoltBlueprint, error) {
+	return c.DescribeBlueprintWithContext(context.Background(), id)
+}
 
-	reqData := map[string]interface{}{
-		"group":           grpPath,
-		"deploymentItems": deployItems,
+// DescribeBlueprintWithContext is the same as DescribeBlueprint with a caller-provided context.
+func (c *CloudBoltClient) DescribeBlueprintWithContext(ctx context.Context, id string) (*CloudBoltBlueprint, error) {
+	var blueprint CloudBoltBlueprint
+	if err := c.getBlueprintJSON(ctx, c.apiEndpoint("cmp", "blueprints", id), &blueprint); err != nil {
+		return nil, err
 	}
 
-	if bpParams != nil {
-		reqData["parameters"] = bpParams
+	schemaPath := blueprint.Links.DeploymentSchema.Href
+	if schemaPath == "" {
+		schemaPath = c.apiEndpoint("cmp", "blueprints", id, "deploymentSchema")
 	}
 
-	reqJSON, err := json.Marshal(reqData)
-	if err != nil {
+	var schema BlueprintDeploymentSchema
+	if err := c.getBlueprintJSON(ctx, schemaPath, &schema); err != nil {
 		return nil, err
 	}
+	blueprint.Schema = &schema
 
+	return &blueprint, nil
+}
+
+// getBlueprintJSON decodes the response from path into out.
+func (c *CloudBoltClient) getBlueprintJSON(ctx context.Context, path string, out interface{}) error {
 	apiurl := c.baseURL
-	apiurl.Path = c.apiEndpoint("cmp", "blueprints", blueprintID, "deploy")
+	apiurl.Path = path
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	defer resp.Body.Close()
 
-	// Handle some common HTTP errors
-	switch {
-	case resp.StatusCode >= 500:
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
-		respBody := buf.String()
-		return nil, fmt.Errorf("received a server error: %s", respBody)
-	case resp.StatusCode >= 400:
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
-		respBody := buf.String()
-		return nil, fmt.Errorf("received an HTTP client error: %s", respBody)
-	default:
-		// We Decode the data because we already have an io.Reader on hand
-		var order CloudBoltOrder
-		json.NewDecoder(resp.Body).Decode(&order)
-
-		return &order, nil
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return err
 	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// DeployBlueprint orders a Blueprint, with its build items given as maps using the keys
+// "bp-item-name", "bp-item-paramas" (sic), "environment" and "osbuild".
+// The maps are converted to a DeploymentRequest and submitted with Deploy, so the request is
+// validated the same way and sends the same body. Deploy takes a typed DeploymentRequest instead.
+func (c *CloudBoltClient) DeployBlueprint(grpPath string, blueprintID string, resourceName string, bpParams map[string]interface{}, bpItems []map[string]interface{}) (*CloudBoltOrder, error) {
+	return c.DeployBlueprintWithContext(context.Background(), grpPath, blueprintID, resourceName, bpParams, bpItems)
+}
+
+// DeployBlueprintWithContext is the same as DeployBlueprint with a caller-provided context.
+func (c *CloudBoltClient) DeployBlueprintWithContext(ctx context.Context, grpPath string, blueprintID string, resourceName string, bpParams map[string]interface{}, bpItems []map[string]interface{}) (*CloudBoltOrder, error) {
+	items, err := deploymentItemsFromMaps(bpItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.DeployWithContext(ctx, &DeploymentRequest{
+		BlueprintID:  blueprintID,
+		Group:        grpPath,
+		ResourceName: resourceName,
+		Parameters:   bpParams,
+		Items:        items,
+	})
+}
+
+// ListBlueprints fetches every Blueprint the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, usually by name:
+//
+//	blueprints, err := client.ListBlueprints(&cbclient.ListOptions{
+//		Filter: cbclient.NewFilter().IContains("name", "web"),
+//	})
+func (c *CloudBoltClient) ListBlueprints(opts *ListOptions) ([]CloudBoltReferenceFields, error) {
+	return c.ListBlueprintsWithContext(context.Background(), opts)
+}
+
+// ListBlueprintsWithContext is the same as ListBlueprints with a caller-provided context.
+func (c *CloudBoltClient) ListBlueprintsWithContext(ctx context.Context, opts *ListOptions) ([]CloudBoltReferenceFields, error) {
+	return NewPaginator[CloudBoltReferenceFields, CloudBoltBlueprintResult](c, c.apiEndpoint("cmp", "blueprints"), opts).ListAll(ctx)
 }
@@ -83,6 +83,56 @@ func TestGetBlueprintById(t *testing.T) {
 	Expect(blueprint.ID).To(Equal("BP-esnjtp7u"))
 }
 
+func TestDescribeBlueprint(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForDescribeBlueprint)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	blueprint, err := client.DescribeBlueprint("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprint).NotTo(BeNil())
+
+	// This should have made four requests:
+	// 1+2. Fail to get the blueprint, get a token
+	// 3. Get the blueprint
+	// 4. Get its deployment schema
+	Expect(len(*requests)).To(Equal(4))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/blueprints/BP-esnjtp7u/"))
+	Expect((*requests)[3].URL.Path).To(Equal("/api/v3/cmp/blueprints/BP-esnjtp7u/deploymentSchema/"))
+
+	// The Blueprint should be parsed correctly
+	Expect(blueprint.Name).To(Equal("My Simple Blueprint"))
+	Expect(blueprint.IsOrderable).To(BeTrue())
+	Expect(blueprint.AnyGroupCanDeploy).To(BeTrue())
+	Expect(blueprint.Links.GroupsThatCanDeploy).To(HaveLen(5))
+	Expect(blueprint.DeploymentItems).To(HaveLen(2))
+	Expect(blueprint.DeploymentItems[0].TierType).To(Equal("server"))
+	Expect(blueprint.DeploymentItems[0].HostnameTemplate).To(Equal("laltomarvm00X"))
+	Expect(blueprint.DeploymentItems[1].ActionName).To(Equal("My Action"))
+
+	// And so should its schema
+	Expect(blueprint.Schema).NotTo(BeNil())
+	Expect(blueprint.Schema.Parameters["owner"].Required).To(BeTrue())
+	Expect(blueprint.Schema.Parameters["tier"].Default).To(Equal("dev"))
+	Expect(blueprint.Schema.Parameters["tier"].Options).To(Equal([]interface{}{"dev", "test", "prod"}))
+
+	item := blueprint.Schema.DeploymentItems["server-bdi-743tlxxu"]
+	Expect(item.TierType).To(Equal("server"))
+	Expect(item.Environments[0].Href).To(Equal("/api/v3/cmp/environments/ENV-1tytr2pu/"))
+	Expect(item.OSBuilds[0].Title).To(Equal("CentOS 7"))
+	Expect(item.Parameters["disk_size"].Type).To(Equal("INT"))
+	Expect(item.Parameters["disk_size"].Default).To(Equal(float64(10)))
+}
+
 func TestDeployBlueprint(t *testing.T) {
 	// Register the test with gomega
 	RegisterTestingT(t)
@@ -207,7 +257,7 @@ func TestDeployBlueprint(t *testing.T) {
 	Expect(order.Links.Duplicate.Title).To(Equal("Duplicate Order"))
 	Expect(order.Name).To(Equal("Installation of My Simple Blueprint"))
 	Expect(order.ID).To(Equal("ORD-e9v87uia"))
-	Expect(order.Status).To(Equal("SUCCESS"))
+	Expect(order.Status).To(Equal(OrderStatusSuccess))
 	Expect(order.Rate).To(Equal("4.18/month"))
 	Expect(len(order.DeploymentItems)).To(Equal(1))
 	Expect(order.DeploymentItems[0].ID).To(Equal("OI-1p0bajs6"))
@@ -218,3 +268,31 @@ func TestDeployBlueprint(t *testing.T) {
 	Expect(order.DeploymentItems[0].BlueprintItemsArguments).To(Not(BeNil()))
 	Expect(order.DeploymentItems[0].ItemType).To(Equal("blueprint"))
 }
+
+func TestListBlueprints(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListBlueprints)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	blueprints, err := client.ListBlueprints(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Blueprints, get a token
+	// 3. Successfully getting the only page of Blueprints
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/blueprints/"))
+
+	Expect(blueprints).To(HaveLen(1))
+	Expect(blueprints[0].ID).To(Equal("BP-esnjtp7u"))
+}
@@ -0,0 +1,491 @@
+package cbclient
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultProfile is the profile LoadConfig uses when none is given.
+const defaultProfile string = "default"
+
+// envPrefix starts the name of every environment variable LoadConfig reads.
+const envPrefix string = "CLOUDBOLT_"
+
+// Config holds the connection settings for a CloudBolt install, as read by LoadConfig.
+// Use Config.NewClient to turn it into a CloudBoltClient.
+type Config struct {
+	// Profile is the config file section the settings came from.
+	Profile string
+	// URL is the full base URL. If it is empty, it is built from Protocol, Host and Port.
+	URL      string
+	Protocol string
+	Host     string
+	Port     string
+
+	Username string
+	// Password is the password itself, after reading PasswordFile or running PasswordCommand.
+	Password string
+	Domain   string
+	// Token is a pre-issued API token, used instead of Username and Password.
+	Token string
+
+	// CABundle is the path to a PEM file of extra CA certificates.
+	CABundle string
+	Proxy    string
+	Timeout  time.Duration
+}
+
+// configKeys maps every config file key to its environment variable and to the
+// Config field it fills. password_file, password_command and token_file are
+// resolved into Password and Token.
+var configKeys = map[string]string{
+	"url":              "URL",
+	"protocol":         "PROTOCOL",
+	"host":             "HOST",
+	"port":             "PORT",
+	"username":         "USERNAME",
+	"password":         "PASSWORD",
+	"password_file":    "PASSWORD_FILE",
+	"password_command": "PASSWORD_COMMAND",
+	"domain":           "DOMAIN",
+	"token":            "API_TOKEN",
+	"token_file":       "API_TOKEN_FILE",
+	"ca_bundle":        "CA_BUNDLE",
+	"proxy":            "PROXY",
+	"timeout":          "TIMEOUT",
+}
+
+// configGroups are keys that say the same thing different ways.
+// If the environment sets any key in a group, the file's values for the whole group are ignored,
+// so CLOUDBOLT_PASSWORD_FILE overrides a password in the file and vice versa.
+var configGroups = [][]string{
+	{"url", "protocol", "host", "port"},
+	{"password", "password_file", "password_command"},
+	{"token", "token_file"},
+}
+
+// authGroups are the two ways of authenticating. If the environment sets any key of one,
+// the file's keys for the other are ignored too, so a token in the file doesn't win over
+// credentials set in the environment, and vice versa. domain goes with either.
+var authGroups = [][]string{
+	{"username", "password", "password_file", "password_command"},
+	{"token", "token_file"},
+}
+
+// LoadConfig reads the settings for a CloudBolt install from a profile in the config file,
+// then overrides them with CLOUDBOLT_* environment variables.
+// Setting any credential (username or password) or token in the environment drops the other kind from the file.
+//
+// The config file is $CLOUDBOLT_CONFIG, or ~/.cloudbolt/config if that isn't set.
+// It is fine for the default file not to exist. The profile is the profile argument,
+// or $CLOUDBOLT_PROFILE, or "default".
+//
+// The file is INI, with one [section] per profile, or YAML, with one mapping per profile:
+//
+//	[default]
+//	url = https://cloudbolt.intranet
+//	username = aUser
+//	password_command = pass show cloudbolt
+//
+//	[prod]
+//	host = cloudbolt.prod.intranet
+//	port = 8443
+//	token_file = ~/.cloudbolt/prod-token
+//
+// Recognized keys, with the matching environment variable:
+//   - url (CLOUDBOLT_URL), or protocol, host and port (CLOUDBOLT_PROTOCOL, CLOUDBOLT_HOST, CLOUDBOLT_PORT)
+//   - username (CLOUDBOLT_USERNAME) and domain (CLOUDBOLT_DOMAIN)
+//   - password (CLOUDBOLT_PASSWORD), password_file (CLOUDBOLT_PASSWORD_FILE) or
+//     password_command (CLOUDBOLT_PASSWORD_COMMAND), run with the system shell
+//   - token (CLOUDBOLT_API_TOKEN) or token_file (CLOUDBOLT_API_TOKEN_FILE)
+//   - ca_bundle (CLOUDBOLT_CA_BUNDLE), proxy (CLOUDBOLT_PROXY) and timeout (CLOUDBOLT_TIMEOUT), e.g., "30s"
+func LoadConfig(profile string) (*Config, error) {
+	path := os.Getenv(envPrefix + "CONFIG")
+	required := path != ""
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err == nil {
+			path = filepath.Join(home, ".cloudbolt", "config")
+		}
+	}
+
+	return loadConfig(path, required, profile)
+}
+
+// LoadConfigFile is LoadConfig with the config file at path, which must exist.
+func LoadConfigFile(path string, profile string) (*Config, error) {
+	return loadConfig(path, true, profile)
+}
+
+// NewClient returns a CloudBoltClient for the settings in the Config.
+// opts are applied after the ones from the Config, so they take precedence.
+func (c *Config) NewClient(opts ...Option) (*CloudBoltClient, error) {
+	baseURL, err := c.baseURL()
+	if err != nil {
+		return nil, err
+	}
+
+	var configOpts []Option
+	switch {
+	case c.Token != "":
+		configOpts = append(configOpts, WithTokenProvider(StaticToken(c.Token)))
+	case c.Username != "" || c.Password != "":
+		configOpts = append(configOpts, WithCredentials(c.Username, c.Password), WithDomain(c.Domain))
+	}
+	if c.CABundle != "" {
+		configOpts = append(configOpts, WithCABundleFile(expandHome(c.CABundle)))
+	}
+	if c.Proxy != "" {
+		configOpts = append(configOpts, WithProxy(c.Proxy))
+	}
+	if c.Timeout != 0 {
+		configOpts = append(configOpts, WithTimeout(c.Timeout))
+	}
+
+	return NewClient(baseURL, append(configOpts, opts...)...)
+}
+
+// baseURL returns URL, or builds one from Protocol, Host and Port.
+func (c *Config) baseURL() (string, error) {
+	if c.URL != "" {
+		return c.URL, nil
+	}
+
+	if c.Host == "" {
+		return "", fmt.Errorf("no CloudBolt URL or host in profile %q", c.Profile)
+	}
+
+	protocol := c.Protocol
+	if protocol == "" {
+		protocol = "https"
+	}
+
+	host := c.Host
+	if c.Port != "" {
+		host = fmt.Sprintf("%s:%s", host, c.Port)
+	}
+
+	return fmt.Sprintf("%s://%s", protocol, host), nil
+}
+
+// loadConfig merges the profile from the file at path with the environment.
+// A missing file is only an error if required is set.
+func loadConfig(path string, required bool, profile string) (*Config, error) {
+	if profile == "" {
+		profile = os.Getenv(envPrefix + "PROFILE")
+	}
+	explicitProfile := profile != ""
+	if profile == "" {
+		profile = defaultProfile
+	}
+
+	values := map[string]string{}
+
+	if path != "" {
+		profiles, err := readConfigFile(path)
+		switch {
+		case errors.Is(err, os.ErrNotExist) && !required:
+		case err != nil:
+			return nil, err
+		default:
+			section, ok := profiles[profile]
+			if !ok && explicitProfile {
+				return nil, fmt.Errorf("profile %q not found in %s", profile, path)
+			}
+			for key, value := range section {
+				values[key] = value
+			}
+		}
+	}
+
+	env := map[string]string{}
+	for key, name := range configKeys {
+		if value, ok := os.LookupEnv(envPrefix + name); ok {
+			env[key] = value
+		}
+	}
+
+	for _, group := range configGroups {
+		if anyKeySet(env, group) {
+			for _, k := range group {
+				delete(values, k)
+			}
+		}
+	}
+	for i, group := range authGroups {
+		if !anyKeySet(env, group) {
+			continue
+		}
+		for j, other := range authGroups {
+			if j == i {
+				continue
+			}
+			for _, k := range other {
+				delete(values, k)
+			}
+		}
+	}
+	for key, value := range env {
+		values[key] = value
+	}
+
+	return newConfig(profile, values)
+}
+
+// anyKeySet reports whether values has any of keys.
+func anyKeySet(values map[string]string, keys []string) bool {
+	for _, key := range keys {
+		if _, ok := values[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newConfig builds a Config from merged settings, resolving the password and token indirection.
+func newConfig(profile string, values map[string]string) (*Config, error) {
+	config := &Config{
+		Profile:  profile,
+		URL:      values["url"],
+		Protocol: values["protocol"],
+		Host:     values["host"],
+		Port:     values["port"],
+		Username: values["username"],
+		Password: values["password"],
+		Domain:   values["domain"],
+		Token:    values["token"],
+		CABundle: values["ca_bundle"],
+		Proxy:    values["proxy"],
+	}
+
+	if timeout := values["timeout"]; timeout != "" {
+		d, err := parseConfigDuration(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout %q: %w", timeout, err)
+		}
+		config.Timeout = d
+	}
+
+	var err error
+	switch {
+	case config.Password != "":
+	case values["password_file"] != "":
+		config.Password, err = readSecretFile(values["password_file"])
+	case values["password_command"] != "":
+		config.Password, err = runSecretCommand(values["password_command"])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading password: %w", err)
+	}
+
+	if config.Token == "" && values["token_file"] != "" {
+		config.Token, err = readSecretFile(values["token_file"])
+		if err != nil {
+			return nil, fmt.Errorf("reading API token: %w", err)
+		}
+	}
+
+	return config, nil
+}
+
+// readConfigFile reads every profile in a YAML or INI config file.
+// Files named *.yaml or *.yml are YAML, *.ini is INI, and anything else is INI
+// if its first setting is a [section] header.
+func readConfigFile(path string) (map[string]map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles map[string]map[string]string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		profiles, err = parseYAMLConfig(data)
+	case ".ini":
+		profiles, err = parseINIConfig(data)
+	default:
+		if looksLikeINI(data) {
+			profiles, err = parseINIConfig(data)
+		} else {
+			profiles, err = parseYAMLConfig(data)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	return profiles, nil
+}
+
+// looksLikeINI reports whether the first line that isn't blank or a comment is a [section].
+func looksLikeINI(data []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		return strings.HasPrefix(line, "[")
+	}
+
+	return false
+}
+
+// parseINIConfig reads "key = value" settings grouped in [profile] sections.
+// Lines starting with # or ; are comments. Values may be quoted.
+func parseINIConfig(data []byte) (map[string]map[string]string, error) {
+	profiles := map[string]map[string]string{}
+
+	var section map[string]string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "["):
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: unterminated section header", lineNumber)
+			}
+			name := strings.TrimSpace(strings.TrimPrefix(line[1:len(line)-1], "profile "))
+			if profiles[name] == nil {
+				profiles[name] = map[string]string{}
+			}
+			section = profiles[name]
+		default:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, fmt.Errorf("line %d: expected key = value", lineNumber)
+			}
+			if section == nil {
+				return nil, fmt.Errorf("line %d: setting outside of a [profile] section", lineNumber)
+			}
+
+			key, err := normalizeConfigKey(key)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			section[key] = unquote(strings.TrimSpace(value))
+		}
+	}
+
+	return profiles, scanner.Err()
+}
+
+// parseYAMLConfig reads a YAML mapping of profile names to mappings of settings.
+func parseYAMLConfig(data []byte) (map[string]map[string]string, error) {
+	var raw map[string]map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	profiles := map[string]map[string]string{}
+	for name, settings := range raw {
+		profiles[name] = map[string]string{}
+		for key, value := range settings {
+			key, err := normalizeConfigKey(key)
+			if err != nil {
+				return nil, fmt.Errorf("profile %q: %w", name, err)
+			}
+
+			switch value.(type) {
+			case map[string]interface{}, []interface{}:
+				return nil, fmt.Errorf("profile %q: %s must be a single value", name, key)
+			case nil:
+				profiles[name][key] = ""
+			default:
+				profiles[name][key] = fmt.Sprint(value)
+			}
+		}
+	}
+
+	return profiles, nil
+}
+
+// normalizeConfigKey lower-cases key, accepts "-" for "_", and rejects keys LoadConfig doesn't know.
+func normalizeConfigKey(key string) (string, error) {
+	key = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(key)), "-", "_")
+	if _, ok := configKeys[key]; !ok {
+		return "", fmt.Errorf("unknown setting %q", key)
+	}
+
+	return key, nil
+}
+
+// unquote removes matching single or double quotes around an INI value.
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+// parseConfigDuration reads a timeout given as a Go duration ("1m30s") or a number of seconds.
+func parseConfigDuration(value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	return time.ParseDuration(value)
+}
+
+// readSecretFile returns the trimmed contents of the file at path.
+func readSecretFile(path string) (string, error) {
+	data, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+// runSecretCommand runs command with the system shell and returns its trimmed output.
+func runSecretCommand(command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("/bin/sh", "-c", command)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%q failed: %w: %s", command, err, msg)
+		}
+		return "", fmt.Errorf("%q failed: %w", command, err)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// expandHome replaces a leading "~/" with the user's home directory.
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, path[2:])
+}
@@ -0,0 +1,271 @@
+package cbclient
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// clearCloudBoltEnv unsets every CLOUDBOLT_* variable for the duration of the test,
+// so the developer's own settings don't leak into it.
+func clearCloudBoltEnv(t *testing.T) {
+	for _, kv := range os.Environ() {
+		name, _, _ := strings.Cut(kv, "=")
+		if strings.HasPrefix(name, envPrefix) {
+			t.Setenv(name, "")
+			os.Unsetenv(name)
+		}
+	}
+
+	// Keep LoadConfig away from the real ~/.cloudbolt/config
+	t.Setenv("HOME", t.TempDir())
+}
+
+// writeTestFile writes content to name in a temporary directory and returns its path.
+func writeTestFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+
+	return path
+}
+
+func TestLoadConfigINI(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+	clearCloudBoltEnv(t)
+
+	passwordFile := writeTestFile(t, "password", "prodPassword\n")
+	path := writeTestFile(t, "config", fmt.Sprintf(anINIConfig, passwordFile))
+
+	config, err := LoadConfigFile(path, "")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(*config).To(Equal(Config{
+		Profile:  "default",
+		URL:      "https://cloudbolt.intranet",
+		Username: "aUser",
+		Password: "aPassword",
+		Domain:   "mydomain.com",
+	}))
+
+	config, err = LoadConfigFile(path, "prod")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(*config).To(Equal(Config{
+		Profile:  "prod",
+		Protocol: "https",
+		Host:     "cloudbolt.prod.intranet",
+		Port:     "8443",
+		Username: "prodUser",
+		Password: "prodPassword",
+		Proxy:    "http://proxy.intranet:3128",
+		Timeout:  30 * time.Second,
+	}))
+
+	baseURL, err := config.baseURL()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(baseURL).To(Equal("https://cloudbolt.prod.intranet:8443"))
+}
+
+func TestLoadConfigYAML(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+	clearCloudBoltEnv(t)
+
+	tokenFile := writeTestFile(t, "token", "Prod Token\n")
+	path := writeTestFile(t, "config.yaml", fmt.Sprintf(aYAMLConfig, tokenFile))
+
+	config, err := LoadConfigFile(path, "default")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(config.URL).To(Equal("https://cloudbolt.intranet"))
+	Expect(config.Password).To(Equal("aPassword"))
+
+	config, err = LoadConfigFile(path, "prod")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(config.Host).To(Equal("cloudbolt.prod.intranet"))
+	Expect(config.Port).To(Equal("8443"))
+	Expect(config.Token).To(Equal("Prod Token"))
+	Expect(config.Timeout).To(Equal(90 * time.Second))
+
+	// YAML is also recognized without the extension
+	plain := writeTestFile(t, "config", fmt.Sprintf(aYAMLConfig, tokenFile))
+	config, err = LoadConfigFile(plain, "prod")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(config.Token).To(Equal("Prod Token"))
+}
+
+func TestLoadConfigEnvironment(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+	clearCloudBoltEnv(t)
+
+	passwordFile := writeTestFile(t, "password", "prodPassword")
+	path := writeTestFile(t, "config", fmt.Sprintf(anINIConfig, passwordFile))
+
+	t.Setenv("CLOUDBOLT_CONFIG", path)
+	t.Setenv("CLOUDBOLT_PROFILE", "prod")
+	t.Setenv("CLOUDBOLT_USERNAME", "envUser")
+	t.Setenv("CLOUDBOLT_PASSWORD_COMMAND", "echo envPassword")
+	t.Setenv("CLOUDBOLT_URL", "https://cloudbolt.env.intranet/cb/")
+
+	config, err := LoadConfig("")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(config.Profile).To(Equal("prod"))
+
+	// Environment variables win over the file, a whole group at a time
+	Expect(config.Username).To(Equal("envUser"))
+	Expect(config.Password).To(Equal("envPassword"))
+	Expect(config.URL).To(Equal("https://cloudbolt.env.intranet/cb/"))
+	Expect(config.Host).To(BeEmpty())
+	Expect(config.Port).To(BeEmpty())
+
+	// Settings the environment doesn't mention still come from the file
+	Expect(config.Proxy).To(Equal("http://proxy.intranet:3128"))
+
+	// An explicit profile argument wins over CLOUDBOLT_PROFILE
+	config, err = LoadConfig("default")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(config.Domain).To(Equal("mydomain.com"))
+}
+
+func TestLoadConfigEnvironmentAuth(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+	clearCloudBoltEnv(t)
+
+	tokenFile := writeTestFile(t, "token", "File Token")
+	path := writeTestFile(t, "config", fmt.Sprintf(`
+[default]
+url = https://cloudbolt.intranet
+token = File Token
+
+[password]
+url = https://cloudbolt.intranet
+username = fileUser
+password = filePassword
+
+[token file]
+url = https://cloudbolt.intranet
+token_file = %s
+`, tokenFile))
+	t.Setenv("CLOUDBOLT_CONFIG", path)
+
+	// Credentials in the environment win over a token in the file
+	t.Setenv("CLOUDBOLT_USERNAME", "envUser")
+	t.Setenv("CLOUDBOLT_PASSWORD", "envPassword")
+
+	config, err := LoadConfig("default")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(config.Token).To(BeEmpty())
+	Expect(config.Username).To(Equal("envUser"))
+	Expect(config.Password).To(Equal("envPassword"))
+
+	// And so do they over a token file
+	config, err = LoadConfig("token file")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(config.Token).To(BeEmpty())
+	Expect(config.Username).To(Equal("envUser"))
+
+	// A token in the environment wins over credentials in the file
+	os.Unsetenv("CLOUDBOLT_USERNAME")
+	os.Unsetenv("CLOUDBOLT_PASSWORD")
+	t.Setenv("CLOUDBOLT_API_TOKEN", "Env Token")
+
+	config, err = LoadConfig("password")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(config.Token).To(Equal("Env Token"))
+	Expect(config.Username).To(BeEmpty())
+	Expect(config.Password).To(BeEmpty())
+}
+
+func TestLoadConfigWithoutFile(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+	clearCloudBoltEnv(t)
+
+	t.Setenv("CLOUDBOLT_HOST", "cloudbolt.intranet")
+	t.Setenv("CLOUDBOLT_API_TOKEN", "Env Token")
+	t.Setenv("CLOUDBOLT_TIMEOUT", "45s")
+
+	// ~/.cloudbolt/config doesn't exist, which is fine
+	config, err := LoadConfig("")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(config.Profile).To(Equal("default"))
+	Expect(config.Host).To(Equal("cloudbolt.intranet"))
+	Expect(config.Token).To(Equal("Env Token"))
+	Expect(config.Timeout).To(Equal(45 * time.Second))
+
+	baseURL, err := config.baseURL()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(baseURL).To(Equal("https://cloudbolt.intranet"))
+
+	// A file that was asked for has to exist
+	t.Setenv("CLOUDBOLT_CONFIG", filepath.Join(t.TempDir(), "missing"))
+	_, err = LoadConfig("")
+	Expect(err).To(HaveOccurred())
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+	clearCloudBoltEnv(t)
+
+	path := writeTestFile(t, "config.ini", "[default]\nurl = https://cloudbolt.intranet\n")
+	_, err := LoadConfigFile(path, "staging")
+	Expect(err).To(MatchError(ContainSubstring(`profile "staging" not found`)))
+
+	cases := map[string]string{
+		"[default]\nusername\n":                        "line 2: expected key = value",
+		"url = https://cloudbolt.intranet\n":           "line 1: setting outside of a [profile] section",
+		"[default\n":                                   "line 1: unterminated section header",
+		"[default]\npasword = oops\n":                  `line 2: unknown setting "pasword"`,
+		"[default]\ntimeout = soon\n":                  `invalid timeout "soon"`,
+		"[default]\npassword_command = exit 3\n":       `reading password: "exit 3" failed`,
+		"[default]\npassword_file = /does/not/exist\n": "reading password",
+	}
+	for content, message := range cases {
+		path := writeTestFile(t, "config.ini", content)
+		_, err := LoadConfigFile(path, "")
+		Expect(err).To(MatchError(ContainSubstring(message)), content)
+	}
+
+	path = writeTestFile(t, "config.yaml", "default:\n  url: [a, b]\n")
+	_, err = LoadConfigFile(path, "")
+	Expect(err).To(MatchError(ContainSubstring("url must be a single value")))
+
+	_, err = (&Config{Profile: "default"}).NewClient()
+	Expect(err).To(MatchError(`no CloudBolt URL or host in profile "default"`))
+}
+
+func TestConfigNewClient(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+	clearCloudBoltEnv(t)
+
+	server, requests := mockServer(responsesForBlueprintById)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	t.Setenv("CLOUDBOLT_URL", server.URL)
+	t.Setenv("CLOUDBOLT_USERNAME", "testUser")
+	t.Setenv("CLOUDBOLT_PASSWORD", "testPass")
+	t.Setenv("CLOUDBOLT_DOMAIN", "mydomain.com")
+
+	config, err := LoadConfig("")
+	Expect(err).NotTo(HaveOccurred())
+
+	client, err := config.NewClient(WithUserAgent("my-tool"))
+	Expect(err).NotTo(HaveOccurred())
+
+	blueprint, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprint.ID).To(Equal("BP-esnjtp7u"))
+
+	Expect(len(*requests)).To(Equal(3))
+	Expect(bodyToString((*requests)[1].Body)).To(MatchJSON(`{"username": "testUser", "password": "testPass", "domain": "mydomain.com"}`))
+	Expect((*requests)[2].Header.Get("User-Agent")).To(Equal("my-tool"))
+}
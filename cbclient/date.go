@@ -0,0 +1,107 @@
+package cbclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CloudBoltTime is a date and time sent by CloudBolt, e.g., a job's StartDate.
+//
+// CloudBolt sends dates in a few formats: "2022-04-10 10:04:15.675759", "2022-04-10T10:04:04.218104",
+// and sometimes with a time zone. Fractional seconds are optional.
+// Times without a time zone are taken to be UTC, which is what CloudBolt uses.
+//
+// A missing date (null or "") is the zero time; check it with IsZero.
+// A date in a format the SDK doesn't know is also the zero time, with the string CloudBolt sent in Raw,
+// so one odd date doesn't fail decoding the whole object.
+// CloudBoltTime is marshalled as an RFC 3339 string, Raw if it couldn't be parsed, or "" for the zero time.
+type CloudBoltTime struct {
+	time.Time
+	// Raw is the date as CloudBolt sent it, if it couldn't be parsed. It is empty otherwise.
+	Raw string
+}
+
+// cloudBoltTimeLayouts are the formats CloudBoltTime can parse, most common first.
+// Fractional seconds are accepted after the seconds even though the layouts leave them out.
+var cloudBoltTimeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	time.RFC3339,
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02 15:04:05Z0700",
+	"2006-01-02",
+}
+
+// ParseCloudBoltTime parses a date in any of the formats CloudBolt sends.
+func ParseCloudBoltTime(value string) (CloudBoltTime, error) {
+	if value == "" {
+		return CloudBoltTime{}, nil
+	}
+
+	for _, layout := range cloudBoltTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return CloudBoltTime{Time: t}, nil
+		}
+	}
+
+	return CloudBoltTime{}, fmt.Errorf("cannot parse %q as a CloudBolt date", value)
+}
+
+// UnmarshalJSON parses a date string in any of the formats CloudBolt sends.
+// It doesn't fail: a value it can't parse, even one that isn't a string, is kept in Raw.
+func (t *CloudBoltTime) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*t = CloudBoltTime{}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		*t = CloudBoltTime{Raw: string(data)}
+		return nil
+	}
+
+	parsed, err := ParseCloudBoltTime(value)
+	if err != nil {
+		*t = CloudBoltTime{Raw: value}
+		return nil
+	}
+
+	*t = parsed
+	return nil
+}
+
+// MarshalJSON formats the time as an RFC 3339 string, Raw if it couldn't be parsed, or "" for the zero time.
+func (t CloudBoltTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return json.Marshal(t.Raw)
+	}
+
+	return json.Marshal(t.Format(time.RFC3339Nano))
+}
+
+// String returns the time in RFC 3339 format, Raw if it couldn't be parsed, or "" for the zero time.
+func (t CloudBoltTime) String() string {
+	if t.IsZero() {
+		return t.Raw
+	}
+
+	return t.Format(time.RFC3339Nano)
+}
+
+// between returns the time from start to end, or until now if end is zero.
+// Returns 0 if start is zero.
+func between(start CloudBoltTime, end CloudBoltTime) time.Duration {
+	if start.IsZero() {
+		return 0
+	}
+	if end.IsZero() {
+		return time.Since(start.Time)
+	}
+
+	return end.Sub(start.Time)
+}
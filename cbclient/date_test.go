@@ -0,0 +1,112 @@
+package cbclient
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseCloudBoltTime(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	want := time.Date(2022, 4, 10, 10, 4, 15, 675759000, time.UTC)
+	for _, value := range []string{
+		"2022-04-10 10:04:15.675759",
+		"2022-04-10T10:04:15.675759",
+		"2022-04-10T10:04:15.675759Z",
+		"2022-04-10T12:04:15.675759+02:00",
+		"2022-04-10 05:04:15.675759-05:00",
+		"2022-04-10T10:04:15.675759+0000",
+	} {
+		parsed, err := ParseCloudBoltTime(value)
+		Expect(err).NotTo(HaveOccurred(), value)
+		Expect(parsed.Equal(want)).To(BeTrue(), value)
+	}
+
+	// Fractional seconds are optional
+	parsed, err := ParseCloudBoltTime("2022-04-10 10:04:15")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(parsed.Time).To(Equal(time.Date(2022, 4, 10, 10, 4, 15, 0, time.UTC)))
+
+	parsed, err = ParseCloudBoltTime("2022-04-10")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(parsed.Time).To(Equal(time.Date(2022, 4, 10, 0, 0, 0, 0, time.UTC)))
+
+	parsed, err = ParseCloudBoltTime("")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(parsed.IsZero()).To(BeTrue())
+
+	_, err = ParseCloudBoltTime("01/21/2021")
+	Expect(err).To(MatchError(`cannot parse "01/21/2021" as a CloudBolt date`))
+}
+
+func TestCloudBoltTimeJSON(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	var dates struct {
+		Start   CloudBoltTime `json:"start"`
+		End     CloudBoltTime `json:"end"`
+		Missing CloudBoltTime `json:"missing"`
+	}
+
+	err := json.Unmarshal([]byte(`{"start": "2022-04-10 10:04:15.675759", "end": null, "missing": ""}`), &dates)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(dates.Start.Time).To(Equal(time.Date(2022, 4, 10, 10, 4, 15, 675759000, time.UTC)))
+	Expect(dates.End.IsZero()).To(BeTrue())
+	Expect(dates.Missing.IsZero()).To(BeTrue())
+
+	data, err := json.Marshal(dates)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(string(data)).To(Equal(`{"start":"2022-04-10T10:04:15.675759Z","end":"","missing":""}`))
+
+	// It round-trips
+	var again struct {
+		Start CloudBoltTime `json:"start"`
+		End   CloudBoltTime `json:"end"`
+	}
+	err = json.Unmarshal(data, &again)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(again.Start).To(Equal(dates.Start))
+	Expect(again.End.IsZero()).To(BeTrue())
+
+	// A date that can't be parsed is kept as-is, without failing the rest of the object
+	var job CloudBoltJob
+	err = json.Unmarshal([]byte(`{"id": "JOB-1", "startDate": "21/01/2021 10:04", "endDate": 1610000000}`), &job)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(job.ID).To(Equal("JOB-1"))
+	Expect(job.StartDate.IsZero()).To(BeTrue())
+	Expect(job.StartDate.Raw).To(Equal("21/01/2021 10:04"))
+	Expect(job.StartDate.String()).To(Equal("21/01/2021 10:04"))
+	Expect(job.EndDate.IsZero()).To(BeTrue())
+	Expect(job.EndDate.Raw).To(Equal("1610000000"))
+
+	// and marshalled back the same way
+	data, err = json.Marshal(job.StartDate)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(string(data)).To(Equal(`"21/01/2021 10:04"`))
+}
+
+func TestJobRunTime(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	created, _ := ParseCloudBoltTime("2022-04-10 10:04:15")
+	started, _ := ParseCloudBoltTime("2022-04-10 10:04:20")
+	ended, _ := ParseCloudBoltTime("2022-04-10 10:06:20")
+
+	job := CloudBoltJob{CreatedDate: created, StartDate: started, EndDate: ended}
+	Expect(job.QueueTime()).To(Equal(5 * time.Second))
+	Expect(job.RunTime()).To(Equal(2 * time.Minute))
+
+	// A job that hasn't started hasn't run
+	queued := CloudBoltJob{CreatedDate: created}
+	Expect(queued.RunTime()).To(BeZero())
+
+	// A running job has been running until now
+	running := CloudBoltJob{StartDate: CloudBoltTime{Time: time.Now().Add(-time.Minute)}}
+	Expect(running.RunTime()).To(BeNumerically(">=", time.Minute))
+}
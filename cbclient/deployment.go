@@ -0,0 +1,396 @@
+package cbclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DeploymentRequest describes an order for a Blueprint, submitted with Deploy.
+type DeploymentRequest struct {
+	// BlueprintID is the ID of the Blueprint to deploy, e.g., "BP-esnjtp7u".
+	BlueprintID string
+	// Group is the path of the Group the order is for, e.g., "/api/v3/cmp/groups/GRP-yfbbsfht/".
+	Group string
+	// ResourceName names the Resource the order creates. CloudBolt picks one if it's empty.
+	ResourceName string
+	// Parameters are the Blueprint-level parameters, by parameter name.
+	Parameters map[string]interface{}
+	// Items configure the Blueprint's build items.
+	Items []DeploymentItem
+}
+
+// DeploymentItem configures one of a Blueprint's build items in a DeploymentRequest.
+type DeploymentItem struct {
+	// Name is the ID of the build item, e.g., "server-bdi-743tlxxu".
+	Name string
+	// Environment is the path of the Environment to deploy a server item to.
+	Environment string
+	// OSBuild is the path of the OS Build for a server item.
+	OSBuild string
+	// Parameters are the item's parameters, by parameter name.
+	Parameters map[string]interface{}
+	// Quantity is how many servers to build for a server item. Zero leaves it to the Blueprint.
+	Quantity int
+}
+
+// ValidationError lists every problem found with a request before it was sent.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid deployment request: " + strings.Join(e.Problems, "; ")
+}
+
+// Validate checks the request has everything CloudBolt needs,
+// and returns a *ValidationError listing every problem if it doesn't.
+func (r *DeploymentRequest) Validate() error {
+	var problems []string
+	if r.BlueprintID == "" {
+		problems = append(problems, "BlueprintID is required")
+	}
+	if r.Group == "" {
+		problems = append(problems, "Group is required")
+	}
+
+	names := make(map[string]bool)
+	for i, item := range r.Items {
+		switch {
+		case item.Name == "":
+			problems = append(problems, fmt.Sprintf("item %d: Name is required", i))
+		case names[item.Name]:
+			problems = append(problems, fmt.Sprintf("item %s: appears more than once", item.Name))
+		}
+		names[item.Name] = true
+
+		if item.Quantity < 0 {
+			problems = append(problems, fmt.Sprintf("item %s: Quantity can't be negative", item.Name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+// ValidateDeployment checks req against the Blueprint it deploys, as described by DescribeBlueprint,
+// without submitting it. It returns a *ValidationError listing every problem found,
+// or the error from fetching the Blueprint.
+func (c *CloudBoltClient) ValidateDeployment(req *DeploymentRequest) error {
+	return c.ValidateDeploymentWithContext(context.Background(), req)
+}
+
+// ValidateDeploymentWithContext is the same as ValidateDeployment with a caller-provided context.
+func (c *CloudBoltClient) ValidateDeploymentWithContext(ctx context.Context, req *DeploymentRequest) error {
+	if req.BlueprintID == "" {
+		return req.Validate()
+	}
+
+	blueprint, err := c.DescribeBlueprintWithContext(ctx, req.BlueprintID)
+	if err != nil {
+		return err
+	}
+
+	return blueprint.ValidateDeployment(req)
+}
+
+// ValidateDeployment checks req against the Blueprint, and returns a *ValidationError listing every problem:
+// those found by req.Validate, a Group that can't deploy the Blueprint, unknown build items,
+// Environments and OS Builds the items don't allow, and missing, unknown or invalid parameters.
+// Parameters are only checked if the Blueprint has a Schema.
+func (b *CloudBoltBlueprint) ValidateDeployment(req *DeploymentRequest) error {
+	var problems []string
+
+	var validationErr *ValidationError
+	if errors.As(req.Validate(), &validationErr) {
+		problems = append(problems, validationErr.Problems...)
+	}
+
+	if !b.IsOrderable {
+		problems = append(problems, fmt.Sprintf("Blueprint %s can't be ordered", b.Name))
+	}
+	if req.Group != "" && !b.AnyGroupCanDeploy && !hasHref(b.Links.GroupsThatCanDeploy, req.Group) {
+		problems = append(problems, fmt.Sprintf("Group %s can't deploy Blueprint %s", req.Group, b.Name))
+	}
+
+	if b.Schema == nil {
+		return validationProblems(problems)
+	}
+
+	problems = append(problems, checkParameters("", b.Schema.Parameters, req.Parameters)...)
+
+	requested := make(map[string]DeploymentItem, len(req.Items))
+	for _, item := range req.Items {
+		if item.Name == "" {
+			continue
+		}
+		requested[item.Name] = item
+
+		if _, ok := b.Schema.DeploymentItems[item.Name]; !ok {
+			problems = append(problems, fmt.Sprintf("item %s: Blueprint %s has no such build item", item.Name, b.Name))
+		}
+	}
+
+	// Items left out of the request still need their required Environment and parameters
+	for _, name := range sortedKeys(b.Schema.DeploymentItems) {
+		schema := b.Schema.DeploymentItems[name]
+		item := requested[name]
+		prefix := "item " + name + ": "
+
+		if len(schema.Environments) > 0 {
+			switch {
+			case item.Environment == "":
+				problems = append(problems, prefix+"Environment is required")
+			case !hasHref(schema.Environments, item.Environment):
+				problems = append(problems, fmt.Sprintf("%sEnvironment %s isn't allowed", prefix, item.Environment))
+			}
+		}
+		if len(schema.OSBuilds) > 0 && item.OSBuild != "" && !hasHref(schema.OSBuilds, item.OSBuild) {
+			problems = append(problems, fmt.Sprintf("%sOS Build %s isn't allowed", prefix, item.OSBuild))
+		}
+
+		problems = append(problems, checkParameters(prefix, schema.Parameters, item.Parameters)...)
+	}
+
+	return validationProblems(problems)
+}
+
+// MarshalJSON returns the body CloudBolt expects at /api/v3/cmp/blueprints/<id>/deploy/.
+func (r DeploymentRequest) MarshalJSON() ([]byte, error) {
+	type deploymentItem struct {
+		Environment string                 `json:"environment,omitempty"`
+		OSBuild     string                 `json:"osBuild,omitempty"`
+		Parameters  map[string]interface{} `json:"parameters,omitempty"`
+		Attributes  map[string]interface{} `json:"attributes,omitempty"`
+	}
+
+	items := make(map[string]deploymentItem, len(r.Items))
+	for _, item := range r.Items {
+		body := deploymentItem{
+			Environment: item.Environment,
+			OSBuild:     item.OSBuild,
+			Parameters:  item.Parameters,
+		}
+		if item.Quantity > 0 {
+			body.Attributes = map[string]interface{}{"quantity": item.Quantity}
+		}
+
+		items[item.Name] = body
+	}
+
+	return json.Marshal(struct {
+		Group           string                    `json:"group"`
+		ResourceName    string                    `json:"resourceName,omitempty"`
+		Parameters      map[string]interface{}    `json:"parameters,omitempty"`
+		DeploymentItems map[string]deploymentItem `json:"deploymentItems"`
+	}{
+		Group:           r.Group,
+		ResourceName:    r.ResourceName,
+		Parameters:      r.Parameters,
+		DeploymentItems: items,
+	})
+}
+
+// Deploy validates the request and submits it, returning the Order CloudBolt created.
+// A request that fails validation returns a *ValidationError and nothing is sent.
+func (c *CloudBoltClient) Deploy(req *DeploymentRequest) (*CloudBoltOrder, error) {
+	return c.DeployWithContext(context.Background(), req)
+}
+
+// DeployWithContext is the same as Deploy with a caller-provided context.
+func (c *CloudBoltClient) DeployWithContext(ctx context.Context, req *DeploymentRequest) (*CloudBoltOrder, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.postDeployment(ctx, req.BlueprintID, reqJSON)
+}
+
+// postDeployment POSTs a deployment body to the Blueprint, and returns the Order CloudBolt created.
+func (c *CloudBoltClient) postDeployment(ctx context.Context, blueprintID string, reqJSON []byte) (*CloudBoltOrder, error) {
+	apiurl := c.baseURL
+	apiurl.Path = c.apiEndpoint("cmp", "blueprints", blueprintID, "deploy")
+
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle some common HTTP errors
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// We Decode the data because we already have an io.Reader on hand
+	var order CloudBoltOrder
+	json.NewDecoder(resp.Body).Decode(&order)
+
+	return &order, nil
+}
+
+// deploymentItemsFromMaps converts DeployBlueprint's build item maps into DeploymentItems.
+// The maps use the keys "bp-item-name", "bp-item-paramas", "environment" and "osbuild".
+func deploymentItemsFromMaps(bpItems []map[string]interface{}) ([]DeploymentItem, error) {
+	items := make([]DeploymentItem, 0, len(bpItems))
+	for i, v := range bpItems {
+		name, ok := v["bp-item-name"].(string)
+		if !ok {
+			return nil, fmt.Errorf("blueprint item %d: bp-item-name must be a string", i)
+		}
+		item := DeploymentItem{Name: name}
+
+		if p, found := v["bp-item-paramas"]; found && p != nil {
+			params, ok := p.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("blueprint item %s: bp-item-paramas must be a map[string]interface{}", name)
+			}
+			item.Parameters = params
+		}
+
+		if env, found := v["environment"]; found {
+			if item.Environment, ok = env.(string); !ok {
+				return nil, fmt.Errorf("blueprint item %s: environment must be a string", name)
+			}
+		}
+
+		if osb, found := v["osbuild"]; found {
+			if item.OSBuild, ok = osb.(string); !ok {
+				return nil, fmt.Errorf("blueprint item %s: osbuild must be a string", name)
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// validationProblems returns a *ValidationError for problems, or nil if there aren't any.
+func validationProblems(problems []string) error {
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Problems: problems}
+}
+
+// checkParameters returns the problems with values, given the parameters described by schema.
+// Each problem starts with prefix.
+func checkParameters(prefix string, schema map[string]BlueprintParameter, values map[string]interface{}) []string {
+	var problems []string
+	for _, name := range sortedKeys(schema) {
+		param := schema[name]
+		if _, ok := values[name]; !ok && param.Required && param.Default == nil {
+			problems = append(problems, fmt.Sprintf("%sparameter %s is required", prefix, name))
+		}
+	}
+
+	for _, name := range sortedKeys(values) {
+		param, ok := schema[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%sunknown parameter %s", prefix, name))
+			continue
+		}
+
+		value := values[name]
+		if !parameterTypeMatches(param.Type, value) {
+			problems = append(problems, fmt.Sprintf("%sparameter %s: %v isn't a valid %s", prefix, name, value, param.Type))
+			continue
+		}
+		if len(param.Options) > 0 && !hasOption(param.Options, value) {
+			problems = append(problems, fmt.Sprintf("%sparameter %s: %v isn't one of the allowed options", prefix, name, value))
+		}
+	}
+
+	return problems
+}
+
+// parameterTypeMatches reports whether value can be used for a parameter of the given CloudBolt field type.
+// Numbers and booleans may also be given as strings, the way the CloudBolt UI sends them.
+// Types other than INT, DEC and BOOL accept anything.
+func parameterTypeMatches(fieldType string, value interface{}) bool {
+	switch strings.ToUpper(fieldType) {
+	case "INT":
+		switch v := value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return true
+		case float32:
+			return v == float32(int64(v))
+		case float64:
+			return v == float64(int64(v))
+		case string:
+			_, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			return err == nil
+		default:
+			return false
+		}
+	case "DEC":
+		switch v := value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+			return true
+		case string:
+			_, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			return err == nil
+		default:
+			return false
+		}
+	case "BOOL":
+		switch v := value.(type) {
+		case bool:
+			return true
+		case string:
+			return strings.EqualFold(v, "true") || strings.EqualFold(v, "false")
+		default:
+			return false
+		}
+	default:
+		return true
+	}
+}
+
+// hasOption reports whether value is one of options, comparing them as text.
+func hasOption(options []interface{}, value interface{}) bool {
+	for _, option := range options {
+		if fmt.Sprint(option) == fmt.Sprint(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasHref reports whether one of items links to href.
+func hasHref(items []CloudBoltHALItem, href string) bool {
+	for _, item := range items {
+		if item.Href == href {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortedKeys returns the keys of m in order, so problems are reported in a stable order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
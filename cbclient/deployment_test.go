@@ -0,0 +1,343 @@
+package cbclient
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDeploy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForDeployBlueprint)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	order, err := client.Deploy(&DeploymentRequest{
+		BlueprintID:  "BP-esnjtp7u",
+		Group:        "/api/v3/cmp/groups/GRP-yfbbsfht/",
+		ResourceName: "My Simple Blueprint",
+		Parameters: map[string]interface{}{
+			"bp_param1": "my parameter 1",
+		},
+		Items: []DeploymentItem{
+			{
+				Name: "plugin-bdi-olk0xwve",
+				Parameters: map[string]interface{}{
+					"actiom_param1": "act1 value",
+				},
+			},
+			{
+				Name:        "server-bdi-743tlxxu",
+				Environment: "/api/v3/cmp/environments/ENV-1tytr2pu/",
+				OSBuild:     "/api/v3/cmp/osBuilds/OSB-z69hjvki/",
+				Parameters: map[string]interface{}{
+					"instance_type": "t2.nano",
+				},
+				Quantity: 2,
+			},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(order).NotTo(BeNil())
+	Expect(order.ID).To(Equal("ORD-e9v87uia"))
+
+	// This should have made three requests:
+	// 1+2. Fail to deploy, get a token
+	// 3. Successful deploy
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/blueprints/BP-esnjtp7u/deploy/"))
+
+	var body map[string]interface{}
+	Expect(json.NewDecoder((*requests)[2].Body).Decode(&body)).To(Succeed())
+	Expect(body).To(Equal(map[string]interface{}{
+		"group":        "/api/v3/cmp/groups/GRP-yfbbsfht/",
+		"resourceName": "My Simple Blueprint",
+		"parameters": map[string]interface{}{
+			"bp_param1": "my parameter 1",
+		},
+		"deploymentItems": map[string]interface{}{
+			"plugin-bdi-olk0xwve": map[string]interface{}{
+				"parameters": map[string]interface{}{
+					"actiom_param1": "act1 value",
+				},
+			},
+			"server-bdi-743tlxxu": map[string]interface{}{
+				"environment": "/api/v3/cmp/environments/ENV-1tytr2pu/",
+				"osBuild":     "/api/v3/cmp/osBuilds/OSB-z69hjvki/",
+				"parameters": map[string]interface{}{
+					"instance_type": "t2.nano",
+				},
+				"attributes": map[string]interface{}{
+					"quantity": float64(2),
+				},
+			},
+		},
+	}))
+}
+
+func TestDeploymentRequestMarshalValue(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	req := DeploymentRequest{
+		BlueprintID: "BP-esnjtp7u",
+		Group:       "/api/v3/cmp/groups/GRP-yfbbsfht/",
+		Items:       []DeploymentItem{{Name: "server-bdi-743tlxxu", Quantity: 2}},
+	}
+	wire := `{
+		"group": "/api/v3/cmp/groups/GRP-yfbbsfht/",
+		"deploymentItems": {"server-bdi-743tlxxu": {"attributes": {"quantity": 2}}}
+	}`
+
+	// A value gets the CloudBolt wire format, not the Go field names
+	data, err := json.Marshal(req)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(data).To(MatchJSON(wire))
+
+	// So does a value inside another struct
+	data, err = json.Marshal(struct {
+		Request DeploymentRequest `json:"request"`
+	}{req})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(data).To(MatchJSON(`{"request": ` + wire + `}`))
+}
+
+func TestDeployInvalidRequest(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForDeployBlueprint)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	order, err := client.Deploy(&DeploymentRequest{
+		Items: []DeploymentItem{
+			{Name: "server-bdi-743tlxxu"},
+			{Name: "server-bdi-743tlxxu", Quantity: -1},
+			{},
+		},
+	})
+	Expect(order).To(BeNil())
+
+	var validationErr *ValidationError
+	Expect(errors.As(err, &validationErr)).To(BeTrue())
+	Expect(validationErr.Problems).To(Equal([]string{
+		"BlueprintID is required",
+		"Group is required",
+		"item server-bdi-743tlxxu: appears more than once",
+		"item server-bdi-743tlxxu: Quantity can't be negative",
+		"item 2: Name is required",
+	}))
+
+	// Nothing should have been sent
+	Expect(*requests).To(BeEmpty())
+}
+
+// DeployBlueprint and Deploy send the same body for the same order.
+func TestDeployBlueprintMatchesDeploy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForDeployBlueprintTwice)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	grpPath := "/api/v3/cmp/groups/GRP-yfbbsfht/"
+	params := map[string]interface{}{"owner": "ops"}
+
+	order, err := client.DeployBlueprint(grpPath, "BP-esnjtp7u", "web", params, []map[string]interface{}{
+		{
+			"bp-item-name":    "server-bdi-743tlxxu",
+			"bp-item-paramas": map[string]interface{}{"instance_type": "t2.nano"},
+			"environment":     "/api/v3/cmp/environments/ENV-1tytr2pu/",
+			"osbuild":         "/api/v3/cmp/osBuilds/OSB-z69hjvki/",
+		},
+		{
+			"bp-item-name": "plugin-bdi-olk0xwve",
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(order.ID).To(Equal("ORD-e9v87uia"))
+
+	order, err = client.Deploy(&DeploymentRequest{
+		BlueprintID:  "BP-esnjtp7u",
+		Group:        grpPath,
+		ResourceName: "web",
+		Parameters:   params,
+		Items: []DeploymentItem{
+			{
+				Name:        "server-bdi-743tlxxu",
+				Environment: "/api/v3/cmp/environments/ENV-1tytr2pu/",
+				OSBuild:     "/api/v3/cmp/osBuilds/OSB-z69hjvki/",
+				Parameters:  map[string]interface{}{"instance_type": "t2.nano"},
+			},
+			{Name: "plugin-bdi-olk0xwve"},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(order.ID).To(Equal("ORD-e9v87uia"))
+
+	// 1+2. The first attempt is unauthorized, get a token
+	// 3. DeployBlueprint's order
+	// 4. Deploy's order
+	Expect(len(*requests)).To(Equal(4))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/blueprints/BP-esnjtp7u/deploy/"))
+	Expect((*requests)[3].URL.Path).To(Equal((*requests)[2].URL.Path))
+
+	body := bodyToString((*requests)[2].Body)
+	Expect(body).To(MatchJSON(bodyToString((*requests)[3].Body)))
+	Expect(body).To(MatchJSON(`{
+		"group": "/api/v3/cmp/groups/GRP-yfbbsfht/",
+		"resourceName": "web",
+		"parameters": {"owner": "ops"},
+		"deploymentItems": {
+			"server-bdi-743tlxxu": {
+				"environment": "/api/v3/cmp/environments/ENV-1tytr2pu/",
+				"osBuild": "/api/v3/cmp/osBuilds/OSB-z69hjvki/",
+				"parameters": {"instance_type": "t2.nano"}
+			},
+			"plugin-bdi-olk0xwve": {}
+		}
+	}`))
+}
+
+func TestDeployBlueprintInvalidItems(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForDeployBlueprint)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	grpPath := "/api/v3/cmp/groups/GRP-yfbbsfht/"
+
+	// These used to panic
+	_, err := client.DeployBlueprint(grpPath, "BP-esnjtp7u", "", nil, []map[string]interface{}{
+		{"bp-item-paramas": map[string]interface{}{}},
+	})
+	Expect(err).To(MatchError("blueprint item 0: bp-item-name must be a string"))
+
+	_, err = client.DeployBlueprint(grpPath, "BP-esnjtp7u", "", nil, []map[string]interface{}{
+		{"bp-item-name": "server-bdi-743tlxxu", "bp-item-paramas": "t2.nano"},
+	})
+	Expect(err).To(MatchError("blueprint item server-bdi-743tlxxu: bp-item-paramas must be a map[string]interface{}"))
+
+	_, err = client.DeployBlueprint(grpPath, "BP-esnjtp7u", "", nil, []map[string]interface{}{
+		{"bp-item-name": "server-bdi-743tlxxu", "environment": 3},
+	})
+	Expect(err).To(MatchError("blueprint item server-bdi-743tlxxu: environment must be a string"))
+
+	// Nothing should have been sent
+	Expect(*requests).To(BeEmpty())
+}
+
+func TestValidateDeployment(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForDescribeBlueprint)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	err := client.ValidateDeployment(&DeploymentRequest{
+		BlueprintID: "BP-esnjtp7u",
+		Group:       "/api/v3/cmp/groups/GRP-yfbbsfht/",
+		Parameters:  map[string]interface{}{"owner": "ops"},
+		Items: []DeploymentItem{{
+			Name:        "server-bdi-743tlxxu",
+			Environment: "/api/v3/cmp/environments/ENV-1tytr2pu/",
+			OSBuild:     "/api/v3/cmp/osBuilds/OSB-z69k4a4x/",
+			Parameters: map[string]interface{}{
+				"instance_type": "t2.micro",
+				"disk_size":     "20",
+				"monitored":     "True",
+			},
+		}},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	// Only the blueprint and its schema should have been fetched; nothing is deployed
+	Expect(len(*requests)).To(Equal(4))
+	Expect((*requests)[3].URL.Path).To(Equal("/api/v3/cmp/blueprints/BP-esnjtp7u/deploymentSchema/"))
+}
+
+func TestValidateDeploymentProblems(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	blueprint := &CloudBoltBlueprint{}
+	Expect(json.Unmarshal([]byte(aBlueprint), blueprint)).To(Succeed())
+	blueprint.Schema = &BlueprintDeploymentSchema{}
+	Expect(json.Unmarshal([]byte(aBlueprintDeploymentSchema), blueprint.Schema)).To(Succeed())
+	blueprint.AnyGroupCanDeploy = false
+
+	err := blueprint.ValidateDeployment(&DeploymentRequest{
+		BlueprintID: "BP-esnjtp7u",
+		Group:       "/api/v3/cmp/groups/GRP-nope/",
+		Parameters:  map[string]interface{}{"tier": "staging", "colour": "blue"},
+		Items: []DeploymentItem{
+			{
+				Name:        "server-bdi-743tlxxu",
+				Environment: "/api/v3/cmp/environments/ENV-other/",
+				OSBuild:     "/api/v3/cmp/osBuilds/OSB-other/",
+				Parameters: map[string]interface{}{
+					"disk_size": 20.5,
+					"monitored": "yes",
+				},
+				Quantity: -1,
+			},
+			{Name: "server-bdi-missing"},
+		},
+	})
+
+	var validationErr *ValidationError
+	Expect(errors.As(err, &validationErr)).To(BeTrue())
+	Expect(validationErr.Problems).To(Equal([]string{
+		"item server-bdi-743tlxxu: Quantity can't be negative",
+		"Group /api/v3/cmp/groups/GRP-nope/ can't deploy Blueprint My Simple Blueprint",
+		"parameter owner is required",
+		"unknown parameter colour",
+		"parameter tier: staging isn't one of the allowed options",
+		"item server-bdi-missing: Blueprint My Simple Blueprint has no such build item",
+		"item server-bdi-743tlxxu: Environment /api/v3/cmp/environments/ENV-other/ isn't allowed",
+		"item server-bdi-743tlxxu: OS Build /api/v3/cmp/osBuilds/OSB-other/ isn't allowed",
+		"item server-bdi-743tlxxu: parameter instance_type is required",
+		"item server-bdi-743tlxxu: parameter disk_size: 20.5 isn't a valid INT",
+		"item server-bdi-743tlxxu: parameter monitored: yes isn't a valid BOOL",
+	}))
+}
@@ -1,11 +1,10 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"net/url"
 	"strconv"
 )
 
@@ -16,15 +15,51 @@ type DNSPolicyResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the DNSPolicies on this page of results.
+func (r *DNSPolicyResult) Items() []DNSPolicy {
+	return r.Embedded.DNSPolicies
+}
+
+type DNSReservationResult struct {
+	CloudBoltResult
+	Embedded struct {
+		DNSReservations []DNSReservation `json:"dnsReservations"`
+	} `json:"_embedded"`
+}
+
+// Items returns the DNS Reservations on this page of results.
+func (r *DNSReservationResult) Items() []DNSReservation {
+	return r.Embedded.DNSReservations
+}
+
 type DNSPolicy struct {
 	Links *struct {
 		Self      CloudBoltHALItem `json:"self,omitempty"`
 		Workspace CloudBoltHALItem `json:"workspace,omitempty"`
 		Endpoint  CloudBoltHALItem `json:"endpoint,omitempty"`
 	} `json:"_links,omitempty"`
-	ID          int    `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	WorkspaceURL string `json:"workspace,omitempty"`
+	// Endpoint is the URL of the DNS endpoint, e.g., "/api/v3/onefuse/endpoints/14/"
+	Endpoint string `json:"endpoint,omitempty"`
+	// Type is the DNS provider, e.g., "infoblox" or "microsoft"
+	Type                       string `json:"type,omitempty"`
+	HostnameOverride           string `json:"hostnameOverride,omitempty"`
+	CreateARecord              bool   `json:"createARecord"`
+	PreValidateARecord         bool   `json:"preValidateARecord"`
+	PostValidateARecord        bool   `json:"postValidateARecord"`
+	CreatePtrRecord            bool   `json:"createPtrRecord"`
+	PreValidatePtrRecord       bool   `json:"preValidatePtrRecord"`
+	PostValidatePtrRecord      bool   `json:"postValidatePtrRecord"`
+	CreateCNameRecord          bool   `json:"createCNameRecord"`
+	PreValidateCNameRecord     bool   `json:"preValidateCNameRecord"`
+	PostValidateCNameRecord    bool   `json:"postValidateCNameRecord"`
+	CreateHostRecord           bool   `json:"createHostRecord"`
+	RemoveFixedAddressRecord   bool   `json:"removeFixedAddressRecord"`
+	PostValidationSleepSeconds string `json:"postValidationSleepSeconds,omitempty"`
+	ValidationTimeoutSeconds   string `json:"validationTimeoutSeconds,omitempty"`
 }
 
 type DNSReservation struct {
@@ -44,17 +79,31 @@ type DNSReservation struct {
 	TemplateProperties map[string]interface{} `json:"templateProperties"`
 }
 
-func (c *CloudBoltClient) GetDNSPolicy(name string) (*DNSPolicy, error) {
+func (c *CloudBoltClient) GetDNSPolicy(name string, filters ...*Filter) (*DNSPolicy, error) {
+	return c.GetDNSPolicyWithContext(context.Background(), name, filters...)
+}
+
+// GetDNSPolicyWithContext is the same as GetDNSPolicy with a caller-provided context.
+func (c *CloudBoltClient) GetDNSPolicyWithContext(ctx context.Context, name string, filters ...*Filter) (*DNSPolicy, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "dnsPolicies")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res DNSPolicyResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -71,10 +120,15 @@ func (c *CloudBoltClient) GetDNSPolicy(name string) (*DNSPolicy, error) {
 }
 
 func (c *CloudBoltClient) CreateDNSReservation(dnsRecord *DNSReservation) (*OneFuseJobStatus, error) {
-	log.Println("onefuse.apiClient: CreateDNSReservation")
+	return c.CreateDNSReservationWithContext(context.Background(), dnsRecord)
+}
+
+// CreateDNSReservationWithContext is the same as CreateDNSReservation with a caller-provided context.
+func (c *CloudBoltClient) CreateDNSReservationWithContext(ctx context.Context, dnsRecord *DNSReservation) (*OneFuseJobStatus, error) {
+	c.log().Debug("onefuse.apiClient: CreateDNSReservation")
 
 	if dnsRecord.WorkspaceURL == "" {
-		workspace, err := c.GetDefaultWorkSpace()
+		workspace, err := c.GetDefaultWorkSpaceWithContext(ctx)
 
 		if err != nil {
 			return nil, err
@@ -101,10 +155,11 @@ func (c *CloudBoltClient) CreateDNSReservation(dnsRecord *DNSReservation) (*OneF
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "dnsReservations")
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -116,13 +171,22 @@ func (c *CloudBoltClient) CreateDNSReservation(dnsRecord *DNSReservation) (*OneF
 }
 
 func (c *CloudBoltClient) GetDNSReservation(dnsReservationPath string) (*DNSReservation, error) {
+	return c.GetDNSReservationWithContext(context.Background(), dnsReservationPath)
+}
+
+// GetDNSReservationWithContext is the same as GetDNSReservation with a caller-provided context.
+func (c *CloudBoltClient) GetDNSReservationWithContext(ctx context.Context, dnsReservationPath string) (*DNSReservation, error) {
 	apiurl := c.baseURL
 	apiurl.Path = dnsReservationPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -134,13 +198,22 @@ func (c *CloudBoltClient) GetDNSReservation(dnsReservationPath string) (*DNSRese
 }
 
 func (c *CloudBoltClient) GetDNSReservationById(dnsReservationId string) (*DNSReservation, error) {
+	return c.GetDNSReservationByIdWithContext(context.Background(), dnsReservationId)
+}
+
+// GetDNSReservationByIdWithContext is the same as GetDNSReservationById with a caller-provided context.
+func (c *CloudBoltClient) GetDNSReservationByIdWithContext(ctx context.Context, dnsReservationId string) (*DNSReservation, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "dnsReservations", dnsReservationId)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -152,13 +225,19 @@ func (c *CloudBoltClient) GetDNSReservationById(dnsReservationId string) (*DNSRe
 }
 
 func (c *CloudBoltClient) DeleteDNSReservation(dnsReservationId string) (*OneFuseJobStatus, error) {
+	return c.DeleteDNSReservationWithContext(context.Background(), dnsReservationId)
+}
+
+// DeleteDNSReservationWithContext is the same as DeleteDNSReservation with a caller-provided context.
+func (c *CloudBoltClient) DeleteDNSReservationWithContext(ctx context.Context, dnsReservationId string) (*OneFuseJobStatus, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "dnsReservations", dnsReservationId)
 
-	resp, err := c.makeRequest("DELETE", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "DELETE", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -168,3 +247,84 @@ func (c *CloudBoltClient) DeleteDNSReservation(dnsReservationId string) (*OneFus
 
 	return job_status, nil
 }
+
+// ListDNSPolicies fetches every DNS Policy the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListDNSPolicies(opts *ListOptions) ([]DNSPolicy, error) {
+	return c.ListDNSPoliciesWithContext(context.Background(), opts)
+}
+
+// ListDNSPoliciesWithContext is the same as ListDNSPolicies with a caller-provided context.
+func (c *CloudBoltClient) ListDNSPoliciesWithContext(ctx context.Context, opts *ListOptions) ([]DNSPolicy, error) {
+	return NewPaginator[DNSPolicy, DNSPolicyResult](c, c.apiEndpoint("onefuse", "dnsPolicies"), opts).ListAll(ctx)
+}
+
+// ListDNSReservations fetches every DNS Reservation the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., NewFilter().Policy(2).Archived(false).
+func (c *CloudBoltClient) ListDNSReservations(opts *ListOptions) ([]DNSReservation, error) {
+	return c.ListDNSReservationsWithContext(context.Background(), opts)
+}
+
+// ListDNSReservationsWithContext is the same as ListDNSReservations with a caller-provided context.
+func (c *CloudBoltClient) ListDNSReservationsWithContext(ctx context.Context, opts *ListOptions) ([]DNSReservation, error) {
+	return NewPaginator[DNSReservation, DNSReservationResult](c, c.apiEndpoint("onefuse", "dnsReservations"), opts).ListAll(ctx)
+}
+
+// CreateDNSPolicy creates a DNS Policy in OneFuse and returns it as saved.
+// If newPolicy has no WorkspaceURL it goes in the default workspace.
+func (c *CloudBoltClient) CreateDNSPolicy(newPolicy *DNSPolicy) (*DNSPolicy, error) {
+	return c.CreateDNSPolicyWithContext(context.Background(), newPolicy)
+}
+
+// CreateDNSPolicyWithContext is the same as CreateDNSPolicy with a caller-provided context.
+func (c *CloudBoltClient) CreateDNSPolicyWithContext(ctx context.Context, newPolicy *DNSPolicy) (*DNSPolicy, error) {
+	var policy DNSPolicy
+	err := c.createPolicy(ctx, "dnsPolicies", &newPolicy.WorkspaceURL, newPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// GetDNSPolicyByID fetches the DNS Policy with the given ID.
+func (c *CloudBoltClient) GetDNSPolicyByID(policyId string) (*DNSPolicy, error) {
+	return c.GetDNSPolicyByIDWithContext(context.Background(), policyId)
+}
+
+// GetDNSPolicyByIDWithContext is the same as GetDNSPolicyByID with a caller-provided context.
+func (c *CloudBoltClient) GetDNSPolicyByIDWithContext(ctx context.Context, policyId string) (*DNSPolicy, error) {
+	var policy DNSPolicy
+	err := c.getPolicy(ctx, "dnsPolicies", policyId, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// UpdateDNSPolicy replaces the DNS Policy with the given ID and returns it as saved.
+func (c *CloudBoltClient) UpdateDNSPolicy(policyId string, updatedPolicy *DNSPolicy) (*DNSPolicy, error) {
+	return c.UpdateDNSPolicyWithContext(context.Background(), policyId, updatedPolicy)
+}
+
+// UpdateDNSPolicyWithContext is the same as UpdateDNSPolicy with a caller-provided context.
+func (c *CloudBoltClient) UpdateDNSPolicyWithContext(ctx context.Context, policyId string, updatedPolicy *DNSPolicy) (*DNSPolicy, error) {
+	var policy DNSPolicy
+	err := c.updatePolicy(ctx, "dnsPolicies", policyId, updatedPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// DeleteDNSPolicy deletes the DNS Policy with the given ID.
+func (c *CloudBoltClient) DeleteDNSPolicy(policyId string) error {
+	return c.DeleteDNSPolicyWithContext(context.Background(), policyId)
+}
+
+// DeleteDNSPolicyWithContext is the same as DeleteDNSPolicy with a caller-provided context.
+func (c *CloudBoltClient) DeleteDNSPolicyWithContext(ctx context.Context, policyId string) error {
+	return c.deletePolicy(ctx, "dnsPolicies", policyId)
+}
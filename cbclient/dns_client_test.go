@@ -196,3 +196,206 @@ func TestDeleteDNSReservation(t *testing.T) {
 	// The CloudBolt Order object should be parsed correctly
 	verifyJobStatus(jobStatus)
 }
+
+func TestListDNSPolicies(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListDNSPolicies)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	policies, err := client.ListDNSPolicies(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the DNS Policies, get a token
+	// 3. Successfully getting the only page of DNS Policies
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/dnsPolicies/"))
+
+	Expect(policies).To(HaveLen(1))
+	Expect(policies[0].ID).To(Equal(9))
+}
+
+func TestListDNSReservations(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListDNSReservations)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	reservations, err := client.ListDNSReservations(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the DNS Reservations, get a token
+	// 3. Successfully getting the only page of DNS Reservations
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/dnsReservations/"))
+
+	Expect(reservations).To(HaveLen(1))
+	Expect(reservations[0].ID).To(Equal(10))
+}
+
+func TestCreateDNSPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleDNSPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	newPolicy := DNSPolicy{
+		Name:                     "my_infoblox_dns_policy",
+		Description:              "An Infoblox DNS Policy created through automated tests",
+		WorkspaceURL:             "/api/v3/onefuse/workspaces/2/",
+		Endpoint:                 "/api/v3/onefuse/endpoints/14/",
+		CreateARecord:            true,
+		ValidationTimeoutSeconds: "180",
+	}
+
+	policy, err := client.CreateDNSPolicy(&newPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to create, get a token
+	// 3. Successful create
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/dnsPolicies/"))
+
+	verifyDNSPolicy(policy)
+}
+
+func TestGetDNSPolicyByID(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleDNSPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	policy, err := client.GetDNSPolicyByID("9")
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get, get a token
+	// 3. Successful Get
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/dnsPolicies/9/"))
+
+	verifyDNSPolicy(policy)
+}
+
+func TestUpdateDNSPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleDNSPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	updatedPolicy := DNSPolicy{
+		Name:                     "my_infoblox_dns_policy",
+		Description:              "An Infoblox DNS Policy created through automated tests",
+		WorkspaceURL:             "/api/v3/onefuse/workspaces/2/",
+		Endpoint:                 "/api/v3/onefuse/endpoints/14/",
+		CreateARecord:            true,
+		ValidationTimeoutSeconds: "180",
+	}
+
+	policy, err := client.UpdateDNSPolicy("9", &updatedPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to update, get a token
+	// 3. Successful update
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("PUT"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/dnsPolicies/9/"))
+
+	verifyDNSPolicy(policy)
+}
+
+func TestDeleteDNSPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleDNSPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	err := client.DeleteDNSPolicy("9")
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to delete, get a token
+	// 3. Successful delete
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("DELETE"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/dnsPolicies/9/"))
+}
+
+func verifyDNSPolicy(policy *DNSPolicy) {
+	Expect(policy.Links.Self.Href).To(Equal("/api/v3/onefuse/dnsPolicies/9/"))
+	Expect(policy.Links.Self.Title).To(Equal("my_infoblox_dns_policy"))
+	Expect(policy.Links.Workspace.Href).To(Equal("/api/v3/onefuse/workspaces/2/"))
+	Expect(policy.ID).To(Equal(9))
+	Expect(policy.Name).To(Equal("my_infoblox_dns_policy"))
+	Expect(policy.Type).To(Equal("infoblox"))
+	Expect(policy.CreateARecord).To(Equal(true))
+	Expect(policy.CreatePtrRecord).To(Equal(false))
+	Expect(policy.ValidationTimeoutSeconds).To(Equal("180"))
+	Expect(policy.Links.Endpoint.Href).To(Equal("/api/v3/onefuse/endpoints/14/"))
+}
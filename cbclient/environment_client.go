@@ -1,9 +1,9 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 type CloudBoltEnvironmentResult struct {
@@ -13,19 +13,37 @@ type CloudBoltEnvironmentResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the Environments on this page of results.
+func (r *CloudBoltEnvironmentResult) Items() []CloudBoltReferenceFields {
+	return r.Embedded.Environments
+}
+
 // GetEnvironment accepts the name of a Environment
-//
-func (c *CloudBoltClient) GetEnvironment(name string) (*CloudBoltReferenceFields, error) {
+func (c *CloudBoltClient) GetEnvironment(name string, filters ...*Filter) (*CloudBoltReferenceFields, error) {
+	return c.GetEnvironmentWithContext(context.Background(), name, filters...)
+}
+
+// GetEnvironmentWithContext is the same as GetEnvironment with a caller-provided context.
+func (c *CloudBoltClient) GetEnvironmentWithContext(ctx context.Context, name string, filters ...*Filter) (*CloudBoltReferenceFields, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "environments")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res CloudBoltEnvironmentResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -41,15 +59,24 @@ func (c *CloudBoltClient) GetEnvironment(name string) (*CloudBoltReferenceFields
 }
 
 func (c *CloudBoltClient) GetEnvironmentById(id string) (*CloudBoltReferenceFields, error) {
+	return c.GetEnvironmentByIdWithContext(context.Background(), id)
+}
+
+// GetEnvironmentByIdWithContext is the same as GetEnvironmentById with a caller-provided context.
+func (c *CloudBoltClient) GetEnvironmentByIdWithContext(ctx context.Context, id string) (*CloudBoltReferenceFields, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "environments", id)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	// We Decode the data because we already have an io.Reader on hand
 	var res CloudBoltReferenceFields
@@ -57,3 +84,19 @@ func (c *CloudBoltClient) GetEnvironmentById(id string) (*CloudBoltReferenceFiel
 
 	return &res, nil
 }
+
+// ListEnvironments fetches every Environment the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by name or by the Resource Handler
+// the Environments belong to:
+//
+//	environments, err := client.ListEnvironments(&cbclient.ListOptions{
+//		Filter: cbclient.NewFilter().Eq("resource_handler__global_id", "RH-nza16uyn"),
+//	})
+func (c *CloudBoltClient) ListEnvironments(opts *ListOptions) ([]CloudBoltReferenceFields, error) {
+	return c.ListEnvironmentsWithContext(context.Background(), opts)
+}
+
+// ListEnvironmentsWithContext is the same as ListEnvironments with a caller-provided context.
+func (c *CloudBoltClient) ListEnvironmentsWithContext(ctx context.Context, opts *ListOptions) ([]CloudBoltReferenceFields, error) {
+	return NewPaginator[CloudBoltReferenceFields, CloudBoltEnvironmentResult](c, c.apiEndpoint("cmp", "environments"), opts).ListAll(ctx)
+}
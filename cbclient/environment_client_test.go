@@ -73,3 +73,31 @@ func TestGetEnvironmentById(t *testing.T) {
 	Expect(environment.Name).To(Equal("MY AWS Environment"))
 	Expect(environment.ID).To(Equal("ENV-1tytr2pu"))
 }
+
+func TestListEnvironments(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListEnvironments)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	environments, err := client.ListEnvironments(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Environments, get a token
+	// 3. Successfully getting the only page of Environments
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/environments/"))
+
+	Expect(environments).To(HaveLen(1))
+	Expect(environments[0].ID).To(Equal("ENV-1tytr2pu"))
+}
@@ -0,0 +1,267 @@
+package cbclient
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Filter builds the query string CloudBolt list endpoints understand:
+//   - filter=field:value;other__icontains:value for the objects to return,
+//   - ordering=field,-other for their order, and
+//   - fields=field,other for the attributes to include.
+//
+// Clauses are combined with AND. Lookups use the Django names, e.g., "icontains", "in", "gt":
+//
+//	f := cbclient.NewFilter().
+//		IContains("hostname", "web").
+//		In("status", "ACTIVE", "PROVISIONING").
+//		OrderBy("-add_date")
+//
+// Every list call, and every call that looks something up by name, accepts Filters.
+// Values are escaped for the URL, but CloudBolt has no way to escape a ";" in a value,
+// or a "," in an In value; such a Filter reports an error through Err and the request isn't sent.
+type Filter struct {
+	clauses  []string
+	ordering []string
+	fields   []string
+	err      error
+}
+
+// NewFilter returns an empty Filter.
+func NewFilter() *Filter {
+	return &Filter{}
+}
+
+// Eq matches objects whose field equals value.
+func (f *Filter) Eq(field string, value interface{}) *Filter {
+	return f.Where(field, "", value)
+}
+
+// Where adds a clause using any of CloudBolt's lookups, e.g., Where("name", "startswith", "web").
+// An empty lookup is an exact match, like Eq.
+func (f *Filter) Where(field string, lookup string, value interface{}) *Filter {
+	s := filterValue(value)
+	if strings.Contains(s, ";") {
+		f.fail(fmt.Errorf("filter value for %s can't contain ';': %q", field, s))
+	}
+
+	return f.add(field, lookup, s)
+}
+
+// IContains matches objects whose field contains value, ignoring case.
+func (f *Filter) IContains(field string, value string) *Filter {
+	return f.Where(field, "icontains", value)
+}
+
+// In matches objects whose field equals any of values.
+func (f *Filter) In(field string, values ...interface{}) *Filter {
+	list := make([]string, 0, len(values))
+	for _, value := range values {
+		s := filterValue(value)
+		if strings.ContainsAny(s, ";,") {
+			f.fail(fmt.Errorf("filter value for %s__in can't contain ';' or ',': %q", field, s))
+		}
+		list = append(list, s)
+	}
+
+	return f.add(field, "in", strings.Join(list, ","))
+}
+
+// Gt matches objects whose field is greater than value.
+func (f *Filter) Gt(field string, value interface{}) *Filter {
+	return f.Where(field, "gt", value)
+}
+
+// Gte matches objects whose field is greater than or equal to value.
+func (f *Filter) Gte(field string, value interface{}) *Filter {
+	return f.Where(field, "gte", value)
+}
+
+// Lt matches objects whose field is less than value.
+func (f *Filter) Lt(field string, value interface{}) *Filter {
+	return f.Where(field, "lt", value)
+}
+
+// Lte matches objects whose field is less than or equal to value.
+func (f *Filter) Lte(field string, value interface{}) *Filter {
+	return f.Where(field, "lte", value)
+}
+
+// OrderBy sorts the results by fields, in order. Prefix a field with "-" to sort it descending.
+func (f *Filter) OrderBy(fields ...string) *Filter {
+	f.ordering = append(f.ordering, fields...)
+	return f
+}
+
+// Fields limits the attributes returned for each object.
+func (f *Filter) Fields(fields ...string) *Filter {
+	f.fields = append(f.fields, fields...)
+	return f
+}
+
+// Workspace matches OneFuse policies and managed objects in the workspace with the given ID.
+func (f *Filter) Workspace(id int) *Filter {
+	return f.Eq("workspace__id", id)
+}
+
+// Policy matches OneFuse managed objects created from the policy with the given ID.
+func (f *Filter) Policy(id int) *Filter {
+	return f.Eq("policy__id", id)
+}
+
+// Hostname matches objects with the given hostname.
+func (f *Filter) Hostname(hostname string) *Filter {
+	return f.Eq("hostname", hostname)
+}
+
+// Status matches objects with any of the given statuses, e.g., Status(cbclient.OrderStatusPending).
+func (f *Filter) Status(statuses ...interface{}) *Filter {
+	if len(statuses) == 1 {
+		return f.Eq("status", statuses[0])
+	}
+
+	return f.In("status", statuses...)
+}
+
+// Group matches objects that belong to the Group with the given ID, e.g., "GRP-yfbbsfht".
+func (f *Filter) Group(id string) *Filter {
+	return f.Eq("group__global_id", id)
+}
+
+// Owner matches objects owned by the user with the given username.
+func (f *Filter) Owner(username string) *Filter {
+	return f.Eq("owner__user__username", username)
+}
+
+// Type matches objects of the given type, e.g., Jobs of type "deploy_blueprint".
+func (f *Filter) Type(objectType string) *Filter {
+	return f.Eq("type", objectType)
+}
+
+// Between matches objects whose date field is between start and end, inclusive.
+// A zero start or end leaves that end of the range open.
+func (f *Filter) Between(field string, start time.Time, end time.Time) *Filter {
+	if !start.IsZero() {
+		f.Gte(field, start)
+	}
+	if !end.IsZero() {
+		f.Lte(field, end)
+	}
+
+	return f
+}
+
+// Archived matches OneFuse managed objects that are, or are not, archived.
+func (f *Filter) Archived(archived bool) *Filter {
+	return f.Eq("archived", archived)
+}
+
+// And adds the clauses, ordering and fields of others to f. Nil Filters are skipped.
+func (f *Filter) And(others ...*Filter) *Filter {
+	for _, other := range others {
+		if other == nil {
+			continue
+		}
+
+		f.clauses = append(f.clauses, other.clauses...)
+		f.ordering = append(f.ordering, other.ordering...)
+		f.fields = append(f.fields, other.fields...)
+		f.fail(other.err)
+	}
+
+	return f
+}
+
+// Err returns the first problem found while building the Filter.
+func (f *Filter) Err() error {
+	if f == nil {
+		return nil
+	}
+
+	return f.err
+}
+
+// Values returns the Filter as query parameters.
+func (f *Filter) Values() url.Values {
+	values := url.Values{}
+	if f == nil {
+		return values
+	}
+
+	if len(f.clauses) > 0 {
+		values.Set("filter", strings.Join(f.clauses, ";"))
+	}
+	if len(f.ordering) > 0 {
+		values.Set("ordering", strings.Join(f.ordering, ","))
+	}
+	if len(f.fields) > 0 {
+		values.Set("fields", strings.Join(f.fields, ","))
+	}
+
+	return values
+}
+
+// Encode returns the Filter as a URL query string.
+func (f *Filter) Encode() string {
+	return encodeQuery(f.Values())
+}
+
+// String returns the same as Encode.
+func (f *Filter) String() string {
+	return f.Encode()
+}
+
+// query returns the encoded Filter, or the error that keeps it from being sent.
+func (f *Filter) query() (string, error) {
+	if err := f.Err(); err != nil {
+		return "", err
+	}
+
+	return f.Encode(), nil
+}
+
+func (f *Filter) add(field string, lookup string, value string) *Filter {
+	if field == "" || strings.ContainsAny(field, ":;") {
+		f.fail(fmt.Errorf("invalid filter field %q", field))
+	}
+
+	if lookup != "" {
+		field += "__" + lookup
+	}
+	f.clauses = append(f.clauses, field+":"+value)
+
+	return f
+}
+
+func (f *Filter) fail(err error) {
+	if f.err == nil {
+		f.err = err
+	}
+}
+
+// filterValue formats value the way CloudBolt parses it.
+func filterValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		// Django only understands the Python spelling
+		if v {
+			return "True"
+		}
+		return "False"
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// encodeQuery is url.Values.Encode, but leaves the ":" and "," of filter clauses readable.
+func encodeQuery(values url.Values) string {
+	return strings.NewReplacer("%3A", ":", "%2C", ",").Replace(values.Encode())
+}
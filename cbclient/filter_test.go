@@ -0,0 +1,115 @@
+package cbclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFilterEncode(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	f := NewFilter().
+		Eq("name", "My Simple Blueprint").
+		IContains("hostname", "web").
+		In("status", "ACTIVE", "PROVISIONING").
+		Gt("id", 10).
+		Lte("add_date", time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)).
+		Eq("is_archived", false).
+		OrderBy("-add_date", "name").
+		Fields("id", "hostname")
+	Expect(f.Err()).NotTo(HaveOccurred())
+
+	values := f.Values()
+	Expect(values.Get("filter")).To(Equal("name:My Simple Blueprint;hostname__icontains:web;status__in:ACTIVE,PROVISIONING;id__gt:10;add_date__lte:2023-01-02T03:04:05Z;is_archived:False"))
+	Expect(values.Get("ordering")).To(Equal("-add_date,name"))
+	Expect(values.Get("fields")).To(Equal("id,hostname"))
+
+	// Values are escaped, but the clause separators stay readable
+	Expect(NewFilter().Eq("name", "a&b c").Where("name", "startswith", "x%").Encode()).To(Equal("filter=name:a%26b+c%3Bname__startswith:x%25"))
+	Expect(NewFilter().Eq("name", "the childgroup").String()).To(Equal("filter=name:the+childgroup"))
+
+	// A nil or empty Filter adds nothing
+	var nilFilter *Filter
+	Expect(nilFilter.Encode()).To(BeEmpty())
+	Expect(nilFilter.Err()).NotTo(HaveOccurred())
+	Expect(NewFilter().Encode()).To(BeEmpty())
+}
+
+func TestFilterAnd(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	f := NewFilter().Eq("name", "web").And(nil, NewFilter().Eq("status", "ACTIVE").OrderBy("name"))
+	Expect(f.Err()).NotTo(HaveOccurred())
+	Expect(f.Encode()).To(Equal("filter=name:web%3Bstatus:ACTIVE&ordering=name"))
+
+	// Errors are carried over too
+	f = NewFilter().Eq("name", "web").And(NewFilter().Eq("name", "a;b"))
+	Expect(f.Err()).To(HaveOccurred())
+}
+
+func TestFilterInvalid(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	Expect(NewFilter().Eq("name", "a;b").Err()).To(MatchError(ContainSubstring("can't contain ';'")))
+	Expect(NewFilter().In("name", "a", "b,c").Err()).To(HaveOccurred())
+	Expect(NewFilter().Eq("", "a").Err()).To(MatchError(`invalid filter field ""`))
+	Expect(NewFilter().Eq("name:x", "a").Err()).To(HaveOccurred())
+
+	// The first error is kept
+	f := NewFilter().Eq("", "a").Eq("name", "a;b")
+	Expect(f.Err()).To(MatchError(`invalid filter field ""`))
+}
+
+func TestGetBlueprintWithFilter(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForBlueprint)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	blueprint, err := client.GetBlueprint("My Simple Blueprint", NewFilter().Eq("is_orderable", true))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprint).NotTo(BeNil())
+
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.RawQuery).To(Equal("filter=name:My+Simple+Blueprint%3Bis_orderable:True"))
+}
+
+func TestGetWithInvalidFilter(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForBlueprint)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// Nothing is sent when the filter can't be expressed
+	blueprint, err := client.GetBlueprint("Simple; Blueprint")
+	Expect(err).To(HaveOccurred())
+	Expect(blueprint).To(BeNil())
+
+	p := NewPaginator[CloudBoltReferenceFields, CloudBoltBlueprintResult](client, "/api/v3/cmp/blueprints/", &ListOptions{
+		Filter: NewFilter().In("name", "a,b"),
+	})
+	Expect(p.HasMore()).To(BeFalse())
+	blueprints, err := p.ListAll(context.Background())
+	Expect(err).To(HaveOccurred())
+	Expect(blueprints).To(BeNil())
+
+	Expect(len(*requests)).To(Equal(0))
+}
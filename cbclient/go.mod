@@ -2,11 +2,13 @@ module github.com/cloudboltsoftware/cloudbolt-go-sdk/cbclient
 
 go 1.18
 
-require github.com/onsi/gomega v1.20.2
+require (
+	github.com/onsi/gomega v1.20.2
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
 	github.com/google/go-cmp v0.5.8 // indirect
 	golang.org/x/net v0.0.0-20220722155237-a158d28d115b // indirect
 	golang.org/x/text v0.3.7 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
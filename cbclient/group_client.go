@@ -1,11 +1,9 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"log"
-	"net/url"
 	"strings"
 )
 
@@ -21,11 +19,21 @@ type CloudBoltGroupResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the Groups on this page of results.
+func (r *CloudBoltGroupResult) Items() []CloudBoltGroup {
+	return r.Embedded.Groups
+}
+
 // GetGroup accepts a groupPath string parameter of the following format:
 // "/my parent group/some subgroup/a child group/" or just "my parent group"
 //
 // verifyGroup recursively verifies that this is a valid group/subgroup.
-func (c *CloudBoltClient) GetGroup(groupPath string) (*CloudBoltGroup, error) {
+func (c *CloudBoltClient) GetGroup(groupPath string, filters ...*Filter) (*CloudBoltGroup, error) {
+	return c.GetGroupWithContext(context.Background(), groupPath, filters...)
+}
+
+// GetGroupWithContext is the same as GetGroup with a caller-provided context.
+func (c *CloudBoltClient) GetGroupWithContext(ctx context.Context, groupPath string, filters ...*Filter) (*CloudBoltGroup, error) {
 	var group string
 	var parentPath string
 	var groupFound bool
@@ -40,11 +48,22 @@ func (c *CloudBoltClient) GetGroup(groupPath string) (*CloudBoltGroup, error) {
 		group = groupPath
 	}
 
+	query, err := NewFilter().Eq("name", group).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "groups")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(group))
+	apiurl.RawQuery = query
+
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	err = checkHttpStatus(resp)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +73,10 @@ func (c *CloudBoltClient) GetGroup(groupPath string) (*CloudBoltGroup, error) {
 	json.NewDecoder(resp.Body).Decode(&res)
 
 	for _, v := range res.Embedded.Groups {
-		groupFound, err = c.verifyGroup(v.Links.Self.Href, parentPath)
+		groupFound, err = c.verifyGroup(ctx, v.Links.Self.Href, parentPath)
+		if err != nil {
+			return nil, err
+		}
 
 		if groupFound {
 			return &v, nil
@@ -65,10 +87,21 @@ func (c *CloudBoltClient) GetGroup(groupPath string) (*CloudBoltGroup, error) {
 }
 
 func (c *CloudBoltClient) GetGroupById(id string) (*CloudBoltGroup, error) {
+	return c.GetGroupByIdWithContext(context.Background(), id)
+}
+
+// GetGroupByIdWithContext is the same as GetGroupById with a caller-provided context.
+func (c *CloudBoltClient) GetGroupByIdWithContext(ctx context.Context, id string) (*CloudBoltGroup, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "groups", id)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	err = checkHttpStatus(resp)
 	if err != nil {
 		return nil, err
 	}
@@ -85,21 +118,22 @@ func (c *CloudBoltClient) GetGroupById(id string) (*CloudBoltGroup, error) {
 //
 // If a group has no parents, "parentPath" should be empty.
 // If a group has parents, it should be of the format "root-level-parent/sub-parent/.../closest-parent"
-func (c *CloudBoltClient) verifyGroup(groupPath string, parentPath string) (bool, error) {
+func (c *CloudBoltClient) verifyGroup(ctx context.Context, groupPath string, parentPath string) (bool, error) {
 	var parent string
 	var nextParentPath string
 
 	apiurl := c.baseURL
 	apiurl.Path = groupPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return false, err
 	}
-	if resp.StatusCode >= 300 {
-		log.Fatalln(resp.Status)
+	defer resp.Body.Close()
 
-		return false, errors.New(resp.Status)
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return false, err
 	}
 
 	// We Decode the data because we already have an io.Reader on hand
@@ -120,8 +154,24 @@ func (c *CloudBoltClient) verifyGroup(groupPath string, parentPath string) (bool
 	}
 
 	if nextParentPath != "" {
-		return c.verifyGroup(group.Parent.Href, nextParentPath)
+		return c.verifyGroup(ctx, group.Parent.Href, nextParentPath)
 	}
 
 	return true, nil
 }
+
+// ListGroups fetches every Group the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by name or parent Group.
+// To list the subgroups of a Group:
+//
+//	groups, err := client.ListGroups(&cbclient.ListOptions{
+//		Filter: cbclient.NewFilter().Eq("parent__global_id", "GRP-yfbbsfht"),
+//	})
+func (c *CloudBoltClient) ListGroups(opts *ListOptions) ([]CloudBoltGroup, error) {
+	return c.ListGroupsWithContext(context.Background(), opts)
+}
+
+// ListGroupsWithContext is the same as ListGroups with a caller-provided context.
+func (c *CloudBoltClient) ListGroupsWithContext(ctx context.Context, opts *ListOptions) ([]CloudBoltGroup, error) {
+	return NewPaginator[CloudBoltGroup, CloudBoltGroupResult](c, c.apiEndpoint("cmp", "groups"), opts).ListAll(ctx)
+}
@@ -1,6 +1,7 @@
 package cbclient
 
 import (
+	"context"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -22,7 +23,7 @@ func TestVerifyGroup(t *testing.T) {
 	sampleGroupPath := "/api/v3/cmp/groups/GRP-zg550a1z/"
 	sampleParentPath := "the group/the subgroup"
 
-	good, err := client.verifyGroup(sampleGroupPath, sampleParentPath)
+	good, err := client.verifyGroup(context.Background(), sampleGroupPath, sampleParentPath)
 	Expect(good).To(BeTrue())
 	Expect(err).NotTo(HaveOccurred())
 
@@ -75,13 +76,14 @@ func TestGetGroupById(t *testing.T) {
 //   - aChildGroup: The real group we are looking for.
 //   - aSubGroup: The parent of aChildGroup, used to verify this is the "real" group.
 //   - aGroup: The parent of aSubGroup, also used to verify this is the "real" group.
+//
 // The calls look like this:
-//   1. Call to the list of groups.
-//   2. Try to verify yetAnotherGroup, which has no parents so it fails.
-//   3. Try to verify aChildGroup, it has the correct parent, so verify the parent.
-//   4. Try to verify aSubGroup, which also has the correct parent, and reaches
-//      the root of the search so we return success in `verifyGroup`, passing the
-//      test and finishing the call to GetGroup().
+//  1. Call to the list of groups.
+//  2. Try to verify yetAnotherGroup, which has no parents so it fails.
+//  3. Try to verify aChildGroup, it has the correct parent, so verify the parent.
+//  4. Try to verify aSubGroup, which also has the correct parent, and reaches
+//     the root of the search so we return success in `verifyGroup`, passing the
+//     test and finishing the call to GetGroup().
 func TestGetGroup(t *testing.T) {
 	// Register the test with gomega
 	RegisterTestingT(t)
@@ -124,3 +126,31 @@ func TestGetGroup(t *testing.T) {
 	Expect(group.Name).To(Equal("the childgroup"))
 	Expect(group.ID).To(Equal("GRP-zg550a1z"))
 }
+
+func TestListGroups(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListGroups)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	groups, err := client.ListGroups(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Groups, get a token
+	// 3. Successfully getting the only page of Groups
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/groups/"))
+
+	Expect(groups).To(HaveLen(2))
+	Expect(groups[0].ID).To(Equal("GRP-zg550a1x"))
+}
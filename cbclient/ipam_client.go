@@ -1,11 +1,10 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"net/url"
 	"strconv"
 )
 
@@ -16,15 +15,54 @@ type IPAMPolicyResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the IPAMPolicies on this page of results.
+func (r *IPAMPolicyResult) Items() []IPAMPolicy {
+	return r.Embedded.IPAMPolicies
+}
+
+type IPAMReservationResult struct {
+	CloudBoltResult
+	Embedded struct {
+		IPAMReservations []IPAMReservation `json:"ipamReservations"`
+	} `json:"_embedded"`
+}
+
+// Items returns the IPAM Reservations on this page of results.
+func (r *IPAMReservationResult) Items() []IPAMReservation {
+	return r.Embedded.IPAMReservations
+}
+
 type IPAMPolicy struct {
 	Links *struct {
 		Self      CloudBoltHALItem `json:"self,omitempty"`
 		Workspace CloudBoltHALItem `json:"workspace,omitempty"`
 		Endpoint  CloudBoltHALItem `json:"endpoint,omitempty"`
 	} `json:"_links,omitempty"`
-	ID          int    `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	WorkspaceURL string `json:"workspace,omitempty"`
+	// Endpoint is the URL of the IPAM endpoint, e.g., "/api/v3/onefuse/endpoints/8/"
+	Endpoint string `json:"endpoint,omitempty"`
+	// Type is the IPAM provider, e.g., "infoblox" or "solarwinds"
+	Type                      string             `json:"type,omitempty"`
+	HostnameOverride          string             `json:"hostnameOverride,omitempty"`
+	UpdateConflictNameWithDNS bool               `json:"updateConflictNameWithDns"`
+	ConflictNameTemplate      string             `json:"conflictNameTemplate,omitempty"`
+	PrimaryDNS                string             `json:"primaryDns,omitempty"`
+	SecondaryDNS              string             `json:"secondaryDns,omitempty"`
+	DNSSuffix                 string             `json:"dnsSuffix,omitempty"`
+	DNSSearchSuffixes         string             `json:"dnsSearchSuffixes,omitempty"`
+	NicLabel                  string             `json:"nicLabel,omitempty"`
+	Subnets                   []IPAMPolicySubnet `json:"subnets,omitempty"`
+}
+
+// IPAMPolicySubnet is one of the subnets an IPAMPolicy reserves addresses from.
+type IPAMPolicySubnet struct {
+	Subnet  string `json:"subnet,omitempty"`
+	Gateway string `json:"gateway,omitempty"`
+	Network string `json:"network,omitempty"`
+	Netmask string `json:"netmask,omitempty"`
 }
 
 type IPAMReservation struct {
@@ -51,17 +89,31 @@ type IPAMReservation struct {
 	TemplateProperties map[string]interface{} `json:"template_properties,omitempty"`
 }
 
-func (c *CloudBoltClient) GetIPAMPolicy(name string) (*IPAMPolicy, error) {
+func (c *CloudBoltClient) GetIPAMPolicy(name string, filters ...*Filter) (*IPAMPolicy, error) {
+	return c.GetIPAMPolicyWithContext(context.Background(), name, filters...)
+}
+
+// GetIPAMPolicyWithContext is the same as GetIPAMPolicy with a caller-provided context.
+func (c *CloudBoltClient) GetIPAMPolicyWithContext(ctx context.Context, name string, filters ...*Filter) (*IPAMPolicy, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "ipamPolicies")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res IPAMPolicyResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -78,10 +130,15 @@ func (c *CloudBoltClient) GetIPAMPolicy(name string) (*IPAMPolicy, error) {
 }
 
 func (c *CloudBoltClient) CreateIPAMReservation(ipamRecord *IPAMReservation) (*OneFuseJobStatus, error) {
-	log.Println("onefuse.apiClient: CreateIPAMReservation")
+	return c.CreateIPAMReservationWithContext(context.Background(), ipamRecord)
+}
+
+// CreateIPAMReservationWithContext is the same as CreateIPAMReservation with a caller-provided context.
+func (c *CloudBoltClient) CreateIPAMReservationWithContext(ctx context.Context, ipamRecord *IPAMReservation) (*OneFuseJobStatus, error) {
+	c.log().Debug("onefuse.apiClient: CreateIPAMReservation")
 
 	if ipamRecord.WorkspaceURL == "" {
-		workspace, err := c.GetDefaultWorkSpace()
+		workspace, err := c.GetDefaultWorkSpaceWithContext(ctx)
 
 		if err != nil {
 			return nil, err
@@ -90,7 +147,6 @@ func (c *CloudBoltClient) CreateIPAMReservation(ipamRecord *IPAMReservation) (*O
 		ipamRecord.WorkspaceURL = workspace.Links.Self.Href
 	}
 
-	log.Println("onefuse.apiClient: CreateModuleDeployment")
 	if ipamRecord.Policy == "" {
 		if ipamRecord.PolicyID != 0 {
 			ipamRecord.Policy = c.apiEndpoint("onefuse", "ipamPolicies", strconv.Itoa(ipamRecord.PolicyID))
@@ -109,10 +165,11 @@ func (c *CloudBoltClient) CreateIPAMReservation(ipamRecord *IPAMReservation) (*O
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "ipamReservations")
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -124,13 +181,22 @@ func (c *CloudBoltClient) CreateIPAMReservation(ipamRecord *IPAMReservation) (*O
 }
 
 func (c *CloudBoltClient) GetIPAMReservation(ipamReservationPath string) (*IPAMReservation, error) {
+	return c.GetIPAMReservationWithContext(context.Background(), ipamReservationPath)
+}
+
+// GetIPAMReservationWithContext is the same as GetIPAMReservation with a caller-provided context.
+func (c *CloudBoltClient) GetIPAMReservationWithContext(ctx context.Context, ipamReservationPath string) (*IPAMReservation, error) {
 	apiurl := c.baseURL
 	apiurl.Path = ipamReservationPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -142,13 +208,22 @@ func (c *CloudBoltClient) GetIPAMReservation(ipamReservationPath string) (*IPAMR
 }
 
 func (c *CloudBoltClient) GetIPAMReservationById(ipamReservationId string) (*IPAMReservation, error) {
+	return c.GetIPAMReservationByIdWithContext(context.Background(), ipamReservationId)
+}
+
+// GetIPAMReservationByIdWithContext is the same as GetIPAMReservationById with a caller-provided context.
+func (c *CloudBoltClient) GetIPAMReservationByIdWithContext(ctx context.Context, ipamReservationId string) (*IPAMReservation, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "ipamReservations", ipamReservationId)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -160,13 +235,19 @@ func (c *CloudBoltClient) GetIPAMReservationById(ipamReservationId string) (*IPA
 }
 
 func (c *CloudBoltClient) DeleteIPAMReservation(ipamReservationId string) (*OneFuseJobStatus, error) {
+	return c.DeleteIPAMReservationWithContext(context.Background(), ipamReservationId)
+}
+
+// DeleteIPAMReservationWithContext is the same as DeleteIPAMReservation with a caller-provided context.
+func (c *CloudBoltClient) DeleteIPAMReservationWithContext(ctx context.Context, ipamReservationId string) (*OneFuseJobStatus, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "ipamReservations", ipamReservationId)
 
-	resp, err := c.makeRequest("DELETE", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "DELETE", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -176,3 +257,84 @@ func (c *CloudBoltClient) DeleteIPAMReservation(ipamReservationId string) (*OneF
 
 	return job_status, nil
 }
+
+// ListIPAMPolicies fetches every IPAM Policy the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListIPAMPolicies(opts *ListOptions) ([]IPAMPolicy, error) {
+	return c.ListIPAMPoliciesWithContext(context.Background(), opts)
+}
+
+// ListIPAMPoliciesWithContext is the same as ListIPAMPolicies with a caller-provided context.
+func (c *CloudBoltClient) ListIPAMPoliciesWithContext(ctx context.Context, opts *ListOptions) ([]IPAMPolicy, error) {
+	return NewPaginator[IPAMPolicy, IPAMPolicyResult](c, c.apiEndpoint("onefuse", "ipamPolicies"), opts).ListAll(ctx)
+}
+
+// ListIPAMReservations fetches every IPAM Reservation the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., NewFilter().Policy(2).Archived(false).
+func (c *CloudBoltClient) ListIPAMReservations(opts *ListOptions) ([]IPAMReservation, error) {
+	return c.ListIPAMReservationsWithContext(context.Background(), opts)
+}
+
+// ListIPAMReservationsWithContext is the same as ListIPAMReservations with a caller-provided context.
+func (c *CloudBoltClient) ListIPAMReservationsWithContext(ctx context.Context, opts *ListOptions) ([]IPAMReservation, error) {
+	return NewPaginator[IPAMReservation, IPAMReservationResult](c, c.apiEndpoint("onefuse", "ipamReservations"), opts).ListAll(ctx)
+}
+
+// CreateIPAMPolicy creates a IPAM Policy in OneFuse and returns it as saved.
+// If newPolicy has no WorkspaceURL it goes in the default workspace.
+func (c *CloudBoltClient) CreateIPAMPolicy(newPolicy *IPAMPolicy) (*IPAMPolicy, error) {
+	return c.CreateIPAMPolicyWithContext(context.Background(), newPolicy)
+}
+
+// CreateIPAMPolicyWithContext is the same as CreateIPAMPolicy with a caller-provided context.
+func (c *CloudBoltClient) CreateIPAMPolicyWithContext(ctx context.Context, newPolicy *IPAMPolicy) (*IPAMPolicy, error) {
+	var policy IPAMPolicy
+	err := c.createPolicy(ctx, "ipamPolicies", &newPolicy.WorkspaceURL, newPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// GetIPAMPolicyByID fetches the IPAM Policy with the given ID.
+func (c *CloudBoltClient) GetIPAMPolicyByID(policyId string) (*IPAMPolicy, error) {
+	return c.GetIPAMPolicyByIDWithContext(context.Background(), policyId)
+}
+
+// GetIPAMPolicyByIDWithContext is the same as GetIPAMPolicyByID with a caller-provided context.
+func (c *CloudBoltClient) GetIPAMPolicyByIDWithContext(ctx context.Context, policyId string) (*IPAMPolicy, error) {
+	var policy IPAMPolicy
+	err := c.getPolicy(ctx, "ipamPolicies", policyId, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// UpdateIPAMPolicy replaces the IPAM Policy with the given ID and returns it as saved.
+func (c *CloudBoltClient) UpdateIPAMPolicy(policyId string, updatedPolicy *IPAMPolicy) (*IPAMPolicy, error) {
+	return c.UpdateIPAMPolicyWithContext(context.Background(), policyId, updatedPolicy)
+}
+
+// UpdateIPAMPolicyWithContext is the same as UpdateIPAMPolicy with a caller-provided context.
+func (c *CloudBoltClient) UpdateIPAMPolicyWithContext(ctx context.Context, policyId string, updatedPolicy *IPAMPolicy) (*IPAMPolicy, error) {
+	var policy IPAMPolicy
+	err := c.updatePolicy(ctx, "ipamPolicies", policyId, updatedPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// DeleteIPAMPolicy deletes the IPAM Policy with the given ID.
+func (c *CloudBoltClient) DeleteIPAMPolicy(policyId string) error {
+	return c.DeleteIPAMPolicyWithContext(context.Background(), policyId)
+}
+
+// DeleteIPAMPolicyWithContext is the same as DeleteIPAMPolicy with a caller-provided context.
+func (c *CloudBoltClient) DeleteIPAMPolicyWithContext(ctx context.Context, policyId string) error {
+	return c.deletePolicy(ctx, "ipamPolicies", policyId)
+}
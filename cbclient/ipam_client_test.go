@@ -214,3 +214,219 @@ func TestDeleteIPAMReservation(t *testing.T) {
 	// The CloudBolt Order object should be parsed correctly
 	verifyJobStatus(jobStatus)
 }
+
+func TestListIPAMPolicies(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListIPAMPolicies)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	policies, err := client.ListIPAMPolicies(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the IPAM Policies, get a token
+	// 3. Successfully getting the only page of IPAM Policies
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ipamPolicies/"))
+
+	Expect(policies).To(HaveLen(1))
+	Expect(policies[0].ID).To(Equal(3))
+}
+
+func TestListIPAMReservations(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListIPAMReservations)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	reservations, err := client.ListIPAMReservations(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the IPAM Reservations, get a token
+	// 3. Successfully getting the only page of IPAM Reservations
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ipamReservations/"))
+
+	Expect(reservations).To(HaveLen(1))
+	Expect(reservations[0].ID).To(Equal(10))
+}
+
+func TestCreateIPAMPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleIPAMPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	newPolicy := IPAMPolicy{
+		Name:             "MY_IPAM_POLICY",
+		Description:      "An IPAM Policy created through automated tests",
+		WorkspaceURL:     "/api/v3/onefuse/workspaces/2/",
+		Endpoint:         "/api/v3/onefuse/endpoints/8/",
+		HostnameOverride: "{{request.hostname}}",
+		Subnets: []IPAMPolicySubnet{
+			{
+				Subnet:  "10.192.50.0/24",
+				Gateway: "10.192.50.1",
+				Network: "mynetwork_10.192.50.0_24",
+				Netmask: "255.255.255.0",
+			},
+		},
+	}
+
+	policy, err := client.CreateIPAMPolicy(&newPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to create, get a token
+	// 3. Successful create
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ipamPolicies/"))
+
+	verifyIPAMPolicy(policy)
+}
+
+func TestGetIPAMPolicyByID(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleIPAMPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	policy, err := client.GetIPAMPolicyByID("3")
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get, get a token
+	// 3. Successful Get
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ipamPolicies/3/"))
+
+	verifyIPAMPolicy(policy)
+}
+
+func TestUpdateIPAMPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleIPAMPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	updatedPolicy := IPAMPolicy{
+		Name:             "MY_IPAM_POLICY",
+		Description:      "An IPAM Policy created through automated tests",
+		WorkspaceURL:     "/api/v3/onefuse/workspaces/2/",
+		Endpoint:         "/api/v3/onefuse/endpoints/8/",
+		HostnameOverride: "{{request.hostname}}",
+		Subnets: []IPAMPolicySubnet{
+			{
+				Subnet:  "10.192.50.0/24",
+				Gateway: "10.192.50.1",
+				Network: "mynetwork_10.192.50.0_24",
+				Netmask: "255.255.255.0",
+			},
+		},
+	}
+
+	policy, err := client.UpdateIPAMPolicy("3", &updatedPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to update, get a token
+	// 3. Successful update
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("PUT"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ipamPolicies/3/"))
+
+	verifyIPAMPolicy(policy)
+}
+
+func TestDeleteIPAMPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleIPAMPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	err := client.DeleteIPAMPolicy("3")
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to delete, get a token
+	// 3. Successful delete
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("DELETE"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/ipamPolicies/3/"))
+}
+
+func verifyIPAMPolicy(policy *IPAMPolicy) {
+	Expect(policy.Links.Self.Href).To(Equal("/api/v3/onefuse/ipamPolicies/3/"))
+	Expect(policy.Links.Self.Title).To(Equal("MY_IPAM_POLICY"))
+	Expect(policy.Links.Workspace.Href).To(Equal("/api/v3/onefuse/workspaces/2/"))
+	Expect(policy.ID).To(Equal(3))
+	Expect(policy.Name).To(Equal("MY_IPAM_POLICY"))
+	Expect(policy.Type).To(Equal("solarwinds"))
+	Expect(policy.HostnameOverride).To(Equal("{{request.hostname}}"))
+	Expect(policy.Subnets[0].Gateway).To(Equal("10.192.50.1"))
+	Expect(policy.Links.Endpoint.Href).To(Equal("/api/v3/onefuse/endpoints/8/"))
+}
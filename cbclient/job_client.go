@@ -1,10 +1,18 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 )
 
+// ErrNoJobInResponse is returned by RequeueJob when CloudBolt accepted the request
+// but its response doesn't describe the Job it queued. The Job was still requeued, so don't retry.
+var ErrNoJobInResponse = errors.New("cbclient: CloudBolt accepted the job action but returned no job")
+
 // CloudBoltJob contains metadata about a Job.
 // Useful for getting the status of a running or completed job.
 type CloudBoltJob struct {
@@ -14,28 +22,98 @@ type CloudBoltJob struct {
 		Parent        CloudBoltHALItem   `json:"parent"`
 		Subjobs       []CloudBoltHALItem `json:"subjobs"`
 		Prerequisite  CloudBoltHALItem   `json:"prerequisite"`
-		DependentJobs []CloudBoltHALItem `json:"dependent-jobs"`
+		DependentJobs []CloudBoltHALItem `json:"dependentJobs"`
 		Order         CloudBoltHALItem   `json:"order"`
 		Resource      CloudBoltHALItem   `json:"resource"`
 		Servers       []CloudBoltHALItem `json:"servers"`
 	} `json:"_links"`
-	ID             string `json:"id"`
-	Type           string `json:"type"`
-	Status         string `json:"status"`
-	WorkerPid      int    `json:"workerPid"`
-	WorkerHostname string `json:"workerHostname"`
-	CanBeRequeued  bool   `json:"canBeRequeued"`
-	CreatedDate    string `json:"createdDate"`
-	UpdatedDate    string `json:"updatedDate"`
-	StartDate      string `json:"startDate"`
-	EndDate        string `json:"endDate"`
-	Output         string `json:"output"`
-	Errors         string `json:"errors"`
-	TasksDone      int    `json:"tasksDone"`
-	TotalTasks     int    `json:"totalTasks"`
-	Label          string `json:"label"`
-	ExecutionState string `json:"executionState"`
-	ProgressMessages  []string `json:"progressMessages"`
+	ID               string         `json:"id"`
+	Type             string         `json:"type"`
+	Status           JobStatus      `json:"status"`
+	WorkerPid        int            `json:"workerPid"`
+	WorkerHostname   string         `json:"workerHostname"`
+	CanBeRequeued    bool           `json:"canBeRequeued"`
+	CreatedDate      CloudBoltTime  `json:"createdDate"`
+	UpdatedDate      CloudBoltTime  `json:"updatedDate"`
+	StartDate        CloudBoltTime  `json:"startDate"`
+	EndDate          CloudBoltTime  `json:"endDate"`
+	Output           string         `json:"output"`
+	Errors           string         `json:"errors"`
+	TasksDone        int            `json:"tasksDone"`
+	TotalTasks       int            `json:"totalTasks"`
+	Label            string         `json:"label"`
+	ExecutionState   ExecutionState `json:"executionState"`
+	ProgressMessages []string       `json:"progressMessages"`
+}
+
+// RunTime returns how long the job ran: from StartDate to EndDate, or until now if it's still running.
+// Returns 0 if the job hasn't started.
+func (j *CloudBoltJob) RunTime() time.Duration {
+	return between(j.StartDate, j.EndDate)
+}
+
+// QueueTime returns how long the job waited to start: from CreatedDate to StartDate,
+// or until now if it hasn't started yet.
+func (j *CloudBoltJob) QueueTime() time.Duration {
+	return between(j.CreatedDate, j.StartDate)
+}
+
+// JobTree is a Job along with the Jobs related to it, as fetched by GetJobTree.
+//
+// Subjobs form a tree under the Job it was fetched from. Prerequisite and DependentJobs
+// point to other nodes, which may be elsewhere in the tree: each Job is fetched once,
+// so a Job reached through several links is the same *JobTree each time.
+type JobTree struct {
+	Job           *CloudBoltJob
+	Subjobs       []*JobTree
+	Prerequisite  *JobTree
+	DependentJobs []*JobTree
+}
+
+// Walk calls fn for each Job in the tree, parents before their Subjobs,
+// and then for DependentJobs that aren't Subjobs. depth is 0 for t itself.
+// Each Job is visited once.
+func (t *JobTree) Walk(fn func(node *JobTree, depth int)) {
+	type queued struct {
+		node  *JobTree
+		depth int
+	}
+
+	seen := make(map[*JobTree]bool)
+	var dependents []queued
+
+	var visit func(node *JobTree, depth int)
+	visit = func(node *JobTree, depth int) {
+		if node == nil || seen[node] {
+			return
+		}
+		seen[node] = true
+
+		fn(node, depth)
+		for _, subjob := range node.Subjobs {
+			visit(subjob, depth+1)
+		}
+		for _, dependent := range node.DependentJobs {
+			dependents = append(dependents, queued{dependent, depth + 1})
+		}
+	}
+
+	visit(t, 0)
+	for i := 0; i < len(dependents); i++ {
+		visit(dependents[i].node, dependents[i].depth)
+	}
+}
+
+type CloudBoltJobResult struct {
+	CloudBoltResult
+	Embedded struct {
+		Jobs []CloudBoltJob `json:"jobs"`
+	} `json:"_embedded"`
+}
+
+// Items returns the Jobs on this page of results.
+func (r *CloudBoltJobResult) Items() []CloudBoltJob {
+	return r.Embedded.Jobs
 }
 
 type OneFuseJobStatus struct {
@@ -46,23 +124,23 @@ type OneFuseJobStatus struct {
 		Policy        CloudBoltHALItem `json:"policy,omitempty"`
 		Workspace     CloudBoltHALItem `json:"workspace,omitempty"`
 	} `json:"_links,omitempty"`
-	ID                  int    `json:"id,omitempty"`
-	JobStateDescription string `json:"jobStateDescription,omitempty"`
-	JobState            string `json:"jobState,omitempty"`
-	JobTrackingID       string `json:"jobTrackingId,omitempty"`
-	JobType             string `json:"jobType,omitempty"`
-	ErrorDetails        *struct {
-		Code   int `json:"code,omitempty"`
-		Errors *[]struct {
-			Message string `json:"message,omitempty"`
-		} `json:"errors,omitempty"`
-	} `json:"errorDetails,omitempty"`
+	ID                  int                  `json:"id,omitempty"`
+	JobStateDescription string               `json:"jobStateDescription,omitempty"`
+	JobState            OneFuseJobState      `json:"jobState,omitempty"`
+	JobTrackingID       string               `json:"jobTrackingId,omitempty"`
+	JobType             string               `json:"jobType,omitempty"`
+	ErrorDetails        *OneFuseErrorDetails `json:"errorDetails,omitempty"`
 }
 
 // GetJob fetches the Job object from CloudBolt at the given path
 // - Job Path (jobPath) e.g., "/api/v2/jobs/123/"
 // - includeProgress: if true, adds ?includeProgress=true to the request
 func (c *CloudBoltClient) GetJob(jobPath string, includeProgress bool) (*CloudBoltJob, error) {
+	return c.GetJobWithContext(context.Background(), jobPath, includeProgress)
+}
+
+// GetJobWithContext is the same as GetJob with a caller-provided context.
+func (c *CloudBoltClient) GetJobWithContext(ctx context.Context, jobPath string, includeProgress bool) (*CloudBoltJob, error) {
 	apiurl := c.baseURL
 	apiurl.Path = jobPath
 
@@ -72,10 +150,14 @@ func (c *CloudBoltClient) GetJob(jobPath string, includeProgress bool) (*CloudBo
 		apiurl.RawQuery = q.Encode()
 	}
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -87,13 +169,22 @@ func (c *CloudBoltClient) GetJob(jobPath string, includeProgress bool) (*CloudBo
 }
 
 func (c *CloudBoltClient) GetJobStatus(jobStatusPath string) (*OneFuseJobStatus, error) {
+	return c.GetJobStatusWithContext(context.Background(), jobStatusPath)
+}
+
+// GetJobStatusWithContext is the same as GetJobStatus with a caller-provided context.
+func (c *CloudBoltClient) GetJobStatusWithContext(ctx context.Context, jobStatusPath string) (*OneFuseJobStatus, error) {
 	apiurl := c.baseURL
 	apiurl.Path = jobStatusPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -103,3 +194,148 @@ func (c *CloudBoltClient) GetJobStatus(jobStatusPath string) (*OneFuseJobStatus,
 
 	return &jobStatus, nil
 }
+
+// ListJobs fetches every Job the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by status, type, owner or date:
+//
+//	jobs, err := client.ListJobs(&cbclient.ListOptions{
+//		Filter: cbclient.NewFilter().
+//			Status(cbclient.JobStatusFailure).
+//			Type("deploy_blueprint").
+//			Between("start_date", lastWeek, time.Time{}),
+//	})
+func (c *CloudBoltClient) ListJobs(opts *ListOptions) ([]CloudBoltJob, error) {
+	return c.ListJobsWithContext(context.Background(), opts)
+}
+
+// ListJobsWithContext is the same as ListJobs with a caller-provided context.
+func (c *CloudBoltClient) ListJobsWithContext(ctx context.Context, opts *ListOptions) ([]CloudBoltJob, error) {
+	return NewPaginator[CloudBoltJob, CloudBoltJobResult](c, c.apiEndpoint("cmp", "jobs"), opts).ListAll(ctx)
+}
+
+// CancelJob asks CloudBolt to cancel a Job that hasn't finished, and returns it.
+// The Job's Status becomes TO_CANCEL until its worker stops it.
+// - Job Path (jobPath) e.g., "/api/v3/cmp/jobs/JOB-9nrax3gb/"
+//
+// If CloudBolt's response doesn't describe the Job, the Job at jobPath is fetched again.
+// An error from that fetch is returned, wrapped, even though the Job was still asked to cancel.
+func (c *CloudBoltClient) CancelJob(jobPath string) (*CloudBoltJob, error) {
+	return c.CancelJobWithContext(context.Background(), jobPath)
+}
+
+// CancelJobWithContext is the same as CancelJob with a caller-provided context.
+func (c *CloudBoltClient) CancelJobWithContext(ctx context.Context, jobPath string) (*CloudBoltJob, error) {
+	return c.postJobAction(ctx, jobPath, "cancel", true)
+}
+
+// RequeueJob runs a finished Job again, and returns the Job CloudBolt queued.
+// Only Jobs with CanBeRequeued set can be requeued.
+// - Job Path (jobPath) e.g., "/api/v3/cmp/jobs/JOB-9nrax3gb/"
+//
+// If CloudBolt's response doesn't describe the queued Job, RequeueJob returns ErrNoJobInResponse.
+// The Job was still requeued then, so don't retry it, or it'll run again.
+func (c *CloudBoltClient) RequeueJob(jobPath string) (*CloudBoltJob, error) {
+	return c.RequeueJobWithContext(context.Background(), jobPath)
+}
+
+// RequeueJobWithContext is the same as RequeueJob with a caller-provided context.
+func (c *CloudBoltClient) RequeueJobWithContext(ctx context.Context, jobPath string) (*CloudBoltJob, error) {
+	return c.postJobAction(ctx, jobPath, "requeue", false)
+}
+
+// postJobAction POSTs to one of the actions of a Job, e.g., "cancel", and returns the Job in the response.
+// If the response doesn't describe a Job, the Job at jobPath is fetched instead when refetch is set,
+// and ErrNoJobInResponse is returned otherwise.
+func (c *CloudBoltClient) postJobAction(ctx context.Context, jobPath string, action string, refetch bool) (*CloudBoltJob, error) {
+	apiurl := c.baseURL
+	apiurl.Path = strings.TrimSuffix(jobPath, "/") + "/" + action + "/"
+
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var job CloudBoltJob
+	if decodeIfPresent(resp.Body, &job) && job.ID != "" {
+		return &job, nil
+	}
+
+	// The action went through, but the response doesn't say what the Job looks like now
+	c.log().Warn("cbclient: job action returned no job", "url", apiurl.String(), "status", resp.StatusCode)
+	if !refetch {
+		return nil, ErrNoJobInResponse
+	}
+
+	fetched, err := c.GetJobWithContext(ctx, jobPath, false)
+	if err != nil {
+		return nil, fmt.Errorf("fetching job %s after %s: %w", jobPath, action, err)
+	}
+
+	return fetched, nil
+}
+
+// GetJobTree fetches a Job along with all of its Subjobs, its Prerequisite and its DependentJobs,
+// and theirs in turn, e.g., to find which step of a Blueprint deployment failed:
+//
+//	tree, err := client.GetJobTree(order.Links.Jobs[0].Href)
+//	tree.Walk(func(node *cbclient.JobTree, depth int) {
+//		fmt.Printf("%s%s %s\n", strings.Repeat("  ", depth), node.Job.Type, node.Job.Status)
+//	})
+//
+// Parent links aren't followed, so the tree starts at the given Job.
+func (c *CloudBoltClient) GetJobTree(jobPath string) (*JobTree, error) {
+	return c.GetJobTreeWithContext(context.Background(), jobPath)
+}
+
+// GetJobTreeWithContext is the same as GetJobTree with a caller-provided context.
+func (c *CloudBoltClient) GetJobTreeWithContext(ctx context.Context, jobPath string) (*JobTree, error) {
+	return c.getJobTree(ctx, jobPath, make(map[string]*JobTree))
+}
+
+// getJobTree fetches the Job at jobPath and everything it links to, unless it's already in fetched.
+func (c *CloudBoltClient) getJobTree(ctx context.Context, jobPath string, fetched map[string]*JobTree) (*JobTree, error) {
+	if node, ok := fetched[jobPath]; ok {
+		return node, nil
+	}
+
+	// Add the node before following its links, so a link back to it ends here
+	node := &JobTree{}
+	fetched[jobPath] = node
+
+	job, err := c.GetJobWithContext(ctx, jobPath, false)
+	if err != nil {
+		return nil, err
+	}
+	node.Job = job
+
+	for _, subjob := range job.Links.Subjobs {
+		child, err := c.getJobTree(ctx, subjob.Href, fetched)
+		if err != nil {
+			return nil, err
+		}
+		node.Subjobs = append(node.Subjobs, child)
+	}
+
+	if job.Links.Prerequisite.Href != "" {
+		node.Prerequisite, err = c.getJobTree(ctx, job.Links.Prerequisite.Href, fetched)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, dependent := range job.Links.DependentJobs {
+		child, err := c.getJobTree(ctx, dependent.Href, fetched)
+		if err != nil {
+			return nil, err
+		}
+		node.DependentJobs = append(node.DependentJobs, child)
+	}
+
+	return node, nil
+}
@@ -1,7 +1,11 @@
 package cbclient
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -61,20 +65,21 @@ func TestGetJob(t *testing.T) {
 	Expect(job.Links.Servers[0].Title).To(Equal("myawainstance1"))
 	Expect(job.ID).To(Equal("JOB-9nrax3gb"))
 	Expect(job.Type).To(Equal("deploy_blueprint"))
-	Expect(job.Status).To(Equal("SUCCESS"))
+	Expect(job.Status).To(Equal(JobStatusSuccess))
 	Expect(job.WorkerPid).To(Equal(20258))
 	Expect(job.WorkerHostname).To(Equal("worker00@42975d51567f"))
 	Expect(job.CanBeRequeued).To(Equal(true))
-	Expect(job.CreatedDate).To(Equal("2022-04-10 10:04:15.071344"))
-	Expect(job.UpdatedDate).To(Equal("2022-04-10 10:07:43.519722"))
-	Expect(job.StartDate).To(Equal("2022-04-10 10:04:15.675759"))
-	Expect(job.EndDate).To(Equal("2022-04-10 10:07:43.519530"))
+	Expect(job.CreatedDate.Time).To(Equal(time.Date(2022, 4, 10, 10, 4, 15, 71344000, time.UTC)))
+	Expect(job.UpdatedDate.Time).To(Equal(time.Date(2022, 4, 10, 10, 7, 43, 519722000, time.UTC)))
+	Expect(job.StartDate.Time).To(Equal(time.Date(2022, 4, 10, 10, 4, 15, 675759000, time.UTC)))
+	Expect(job.EndDate.Time).To(Equal(time.Date(2022, 4, 10, 10, 7, 43, 519530000, time.UTC)))
+	Expect(job.RunTime()).To(Equal(3*time.Minute + 27*time.Second + 843771*time.Microsecond))
 	Expect(job.Output).To(Equal("Blueprint deployed successfully"))
 	Expect(job.Errors).To(Equal(""))
 	Expect(job.TasksDone).To(Equal(3))
 	Expect(job.TotalTasks).To(Equal(3))
 	Expect(job.Label).To(Equal(""))
-	Expect(job.ExecutionState).To(Equal(""))
+	Expect(job.ExecutionState).To(Equal(ExecutionStateNone))
 }
 
 func TestGetJobStatus(t *testing.T) {
@@ -123,7 +128,217 @@ func verifyJobStatus(jobStatus *OneFuseJobStatus) {
 	Expect(jobStatus.Links.Workspace.Title).To(Equal("Default"))
 	Expect(jobStatus.ID).To(Equal(3280))
 	Expect(jobStatus.JobStateDescription).To(Equal("Successful"))
-	Expect(jobStatus.JobState).To(Equal("Successful"))
+	Expect(jobStatus.JobState).To(Equal(OneFuseJobStateSuccessful))
 	Expect(jobStatus.JobTrackingID).To(Equal("3474c59f-6ca0-4d99-82ea-e1b98fca71c6"))
 	Expect(jobStatus.JobType).To(Equal("Provision Email Notification"))
 }
+
+func TestListJobs(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListJobs)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	jobs, err := client.ListJobs(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Jobs, get a token
+	// 3. Successfully getting the only page of Jobs
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/jobs/"))
+
+	Expect(jobs).To(HaveLen(1))
+	Expect(jobs[0].ID).To(Equal("JOB-9nrax3gb"))
+}
+
+func TestListJobsWithFilter(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListJobs)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	jobs, err := client.ListJobs(&ListOptions{
+		Filter: NewFilter().
+			Status(JobStatusFailure).
+			Type("deploy_blueprint").
+			Owner("user001").
+			Between("start_date", time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC), time.Time{}),
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(jobs).To(HaveLen(1))
+
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/jobs/"))
+	Expect((*requests)[2].URL.Query().Get("filter")).To(Equal("status:FAILURE;type:deploy_blueprint;owner__user__username:user001;start_date__gte:2022-04-01T00:00:00Z"))
+}
+
+func TestCancelJob(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForCancelJob)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	job, err := client.CancelJob("/api/v3/cmp/jobs/JOB-9nrax3gb/")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(job.Status).To(Equal(JobStatusToCancel))
+
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/jobs/JOB-9nrax3gb/cancel/"))
+}
+
+func TestRequeueJob(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForRequeueJob)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// A path without the trailing slash works too
+	job, err := client.RequeueJob("/api/v3/cmp/jobs/JOB-9nrax3gb")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(job.ID).To(Equal("JOB-r4q8ue2x"))
+	Expect(job.Status).To(Equal(JobStatusQueued))
+
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/jobs/JOB-9nrax3gb/requeue/"))
+}
+
+func TestGetJobTree(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForGetJobTree)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	tree, err := client.GetJobTree("/api/v3/cmp/jobs/JOB-9nrax3gb/")
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made five requests:
+	// 1+2. Fail to get the Job, get a token
+	// 3. Get the Job
+	// 4. Get its first subjob
+	// 5. Get the first subjob's dependent job, which is also the second subjob
+	Expect(len(*requests)).To(Equal(5))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/jobs/JOB-9nrax3gb/"))
+	Expect((*requests)[3].URL.Path).To(Equal("/api/v3/cmp/jobs/JOB-kb0tuw1e/"))
+	Expect((*requests)[4].URL.Path).To(Equal("/api/v3/cmp/jobs/JOB-t2js3lwf/"))
+
+	Expect(tree.Job.ID).To(Equal("JOB-9nrax3gb"))
+	Expect(tree.Subjobs).To(HaveLen(2))
+	Expect(tree.Prerequisite).To(BeNil())
+
+	provision, action := tree.Subjobs[0], tree.Subjobs[1]
+	Expect(provision.Job.ID).To(Equal("JOB-kb0tuw1e"))
+	Expect(action.Job.ID).To(Equal("JOB-t2js3lwf"))
+	Expect(action.Job.Status).To(Equal(JobStatusFailure))
+
+	// The dependentJobs links are decoded
+	Expect(provision.Job.Links.DependentJobs).To(Equal([]CloudBoltHALItem{{
+		Href:  "/api/v3/cmp/jobs/JOB-t2js3lwf/",
+		Title: "My Action Job 1013",
+	}}))
+
+	// Links between Jobs point to the same nodes
+	Expect(provision.DependentJobs).To(Equal([]*JobTree{action}))
+	Expect(action.Prerequisite).To(BeIdenticalTo(provision))
+
+	// Walk visits every Job once, as part of the subjob hierarchy where it can
+	var visited []string
+	tree.Walk(func(node *JobTree, depth int) {
+		visited = append(visited, fmt.Sprintf("%s@%d", node.Job.ID, depth))
+	})
+	Expect(visited).To(Equal([]string{"JOB-9nrax3gb@0", "JOB-kb0tuw1e@1", "JOB-t2js3lwf@1"}))
+}
+
+func TestJobActionsWithoutJob(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// A 204 No Content is a success, and the Job is fetched instead
+	server, requests := mockServer(responsesForCancelJobNoContent)
+	client := getClient(server)
+
+	job, err := client.CancelJob("/api/v3/cmp/jobs/JOB-9nrax3gb/")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(job.Status).To(Equal(JobStatusToCancel))
+
+	Expect(len(*requests)).To(Equal(4))
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[3].Method).To(Equal("GET"))
+	Expect((*requests)[3].URL.Path).To(Equal("/api/v3/cmp/jobs/JOB-9nrax3gb/"))
+
+	// So is a JSON response that isn't a Job
+	server, requests = mockServer(responsesForCancelJobStatusOnly)
+	client = getClient(server)
+
+	job, err = client.CancelJob("/api/v3/cmp/jobs/JOB-9nrax3gb/")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(job.Status).To(Equal(JobStatusToCancel))
+
+	Expect(len(*requests)).To(Equal(4))
+	Expect((*requests)[3].Method).To(Equal("GET"))
+	Expect((*requests)[3].URL.Path).To(Equal("/api/v3/cmp/jobs/JOB-9nrax3gb/"))
+
+	// If fetching the Job fails, that's the error returned
+	server, requests = mockServer(responsesForCancelJobUnreadable)
+	client = getClient(server)
+
+	job, err = client.CancelJob("/api/v3/cmp/jobs/JOB-9nrax3gb/")
+	Expect(job).To(BeNil())
+	Expect(err).To(HaveOccurred())
+
+	var apiErr *APIError
+	Expect(errors.As(err, &apiErr)).To(BeTrue())
+	Expect(apiErr.StatusCode).To(Equal(http.StatusInternalServerError))
+	Expect(len(*requests)).To(Equal(4))
+
+	// A requeued Job may not be the one at jobPath, so it isn't fetched
+	server, requests = mockServer(responsesForRequeueJobUnreadable)
+	client = getClient(server)
+
+	job, err = client.RequeueJob("/api/v3/cmp/jobs/JOB-9nrax3gb/")
+	Expect(job).To(BeNil())
+	Expect(errors.Is(err, ErrNoJobInResponse)).To(BeTrue())
+	Expect(len(*requests)).To(Equal(3))
+}
@@ -0,0 +1,168 @@
+package cbclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// redacted replaces the value of any sensitive field in a logged body.
+const redacted string = "REDACTED"
+
+// Logger receives the SDK's diagnostic output.
+// keysAndValues are alternating key/value pairs, the same convention as log/slog,
+// so a *slog.Logger satisfies Logger as-is. See NewSlogLogger.
+//
+// - Debug gets every request and response, including redacted bodies.
+// - Info gets authentication events.
+// - Warn gets non-2xx responses and re-authentication attempts.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+}
+
+// debugEnabler is implemented by loggers that can tell us whether Debug output
+// is discarded, so request and response bodies are only buffered when they will be logged.
+type debugEnabler interface {
+	DebugEnabled() bool
+}
+
+// nopLogger is the default Logger. It discards everything.
+type nopLogger struct{}
+
+func (nopLogger) Debug(msg string, keysAndValues ...interface{}) {}
+func (nopLogger) Info(msg string, keysAndValues ...interface{})  {}
+func (nopLogger) Warn(msg string, keysAndValues ...interface{})  {}
+func (nopLogger) DebugEnabled() bool                             { return false }
+
+// SetLogger sets the Logger used by the CloudBoltClient.
+// Passing nil silences the client again, which is the default.
+func (c *CloudBoltClient) SetLogger(logger Logger) {
+	if logger == nil {
+		logger = nopLogger{}
+	}
+
+	c.logger = logger
+}
+
+// log returns the configured Logger, or a silent one if none was set.
+func (c *CloudBoltClient) log() Logger {
+	if c.logger == nil {
+		return nopLogger{}
+	}
+
+	return c.logger
+}
+
+// debugEnabled reports whether Debug output would be kept.
+// Loggers that don't implement debugEnabler are assumed to want everything.
+func (c *CloudBoltClient) debugEnabled() bool {
+	if d, ok := c.log().(debugEnabler); ok {
+		return d.DebugEnabled()
+	}
+
+	return true
+}
+
+// sendRequest sends req once with the configured http.Client and User-Agent, and logs the exchange.
+// Bodies are only read for logging when Debug output is enabled.
+// The Authorization header is never logged.
+func (c *CloudBoltClient) sendRequest(req *http.Request) (*http.Response, error) {
+	logger := c.log()
+	debug := c.debugEnabled()
+
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	if debug {
+		keysAndValues := []interface{}{"method", req.Method, "url", req.URL.String()}
+		if req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				reqBody, _ := io.ReadAll(body)
+				body.Close()
+				if len(reqBody) > 0 {
+					keysAndValues = append(keysAndValues, "body", redactBody(reqBody))
+				}
+			}
+		}
+		logger.Debug("cbclient: request", keysAndValues...)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		logger.Warn("cbclient: request failed", "method", req.Method, "url", req.URL.String(), "error", err)
+		return nil, err
+	}
+
+	if debug {
+		// Read the body for logging, then put it back for the caller
+		respBody, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+		logger.Debug("cbclient: response", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode, "body", redactBody(respBody))
+	}
+
+	if resp.StatusCode >= 400 {
+		logger.Warn("cbclient: unsuccessful response", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode)
+	}
+
+	return resp, nil
+}
+
+// redactBody returns a JSON body as a string with credentials and tokens replaced.
+// Anything that isn't JSON is summarized rather than logged, since we can't tell what's in it.
+func redactBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return fmt.Sprintf("<%d bytes, not JSON>", len(body))
+	}
+
+	out, err := json.Marshal(redactValue(data))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+
+	return string(out)
+}
+
+// redactValue walks decoded JSON and replaces the value of every sensitive key.
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitiveKey(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	}
+
+	return v
+}
+
+// isSensitiveKey reports whether a JSON key names a credential or a token.
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range []string{"password", "passwd", "token", "secret", "credential", "apikey", "api_key", "authorization"} {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+//go:build go1.21
+
+package cbclient
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSlogLoggerInfoLevel(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, _ := mockServer(responsesForBlueprint)
+	Expect(server).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	var buf bytes.Buffer
+	client.SetLogger(NewSlogLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))))
+	Expect(client.debugEnabled()).To(BeFalse())
+
+	_, err := client.GetBlueprint("My Simple Blueprint")
+	Expect(err).NotTo(HaveOccurred())
+
+	// Authentication events are logged, bodies are not
+	Expect(buf.String()).To(ContainSubstring("level=INFO"))
+	Expect(buf.String()).To(ContainSubstring("level=WARN"))
+	Expect(buf.String()).NotTo(ContainSubstring("level=DEBUG"))
+	Expect(buf.String()).NotTo(ContainSubstring("body="))
+}
+
+func TestSlogLoggerDebugLevel(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, _ := mockServer(responsesForBlueprint)
+	Expect(server).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	var buf bytes.Buffer
+	client.SetLogger(NewSlogLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))))
+	Expect(client.debugEnabled()).To(BeTrue())
+
+	_, err := client.GetBlueprint("My Simple Blueprint")
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(buf.String()).To(ContainSubstring("level=DEBUG"))
+	Expect(buf.String()).To(ContainSubstring("body="))
+	Expect(buf.String()).To(ContainSubstring("REDACTED"))
+	Expect(buf.String()).NotTo(ContainSubstring("testPass"))
+	Expect(buf.String()).NotTo(ContainSubstring("Testing Token"))
+}
@@ -0,0 +1,133 @@
+package cbclient
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+// logEntry is a single call to recordingLogger.
+type logEntry struct {
+	Level         string
+	Msg           string
+	KeysAndValues []interface{}
+}
+
+// String formats the entry the way a text handler would, so tests can match on it.
+func (e logEntry) String() string {
+	return fmt.Sprintf("%s %s %v", e.Level, e.Msg, e.KeysAndValues)
+}
+
+// recordingLogger keeps every entry so tests can inspect what was logged.
+type recordingLogger struct {
+	entries []logEntry
+}
+
+func (l *recordingLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.entries = append(l.entries, logEntry{"DEBUG", msg, keysAndValues})
+}
+
+func (l *recordingLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.entries = append(l.entries, logEntry{"INFO", msg, keysAndValues})
+}
+
+func (l *recordingLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.entries = append(l.entries, logEntry{"WARN", msg, keysAndValues})
+}
+
+// all returns every entry formatted as one string.
+func (l *recordingLogger) all() string {
+	lines := make([]string, 0, len(l.entries))
+	for _, e := range l.entries {
+		lines = append(lines, e.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func TestLoggerSilentByDefault(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, _ := mockServer(responsesForBlueprint)
+	Expect(server).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// The default logger discards everything, so bodies are never buffered for it
+	Expect(client.log()).To(Equal(Logger(nopLogger{})))
+	Expect(client.debugEnabled()).To(BeFalse())
+
+	_, err := client.GetBlueprint("My Simple Blueprint")
+	Expect(err).NotTo(HaveOccurred())
+}
+
+func TestLoggerRedactsCredentials(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForBlueprint)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	logger := &recordingLogger{}
+	client.SetLogger(logger)
+
+	blueprint, err := client.GetBlueprint("My Simple Blueprint")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprint).NotTo(BeNil())
+	Expect(len(*requests)).To(Equal(3))
+
+	output := logger.all()
+
+	// The 401, the token request and the retried request are all visible
+	Expect(output).To(ContainSubstring("WARN cbclient: re-authenticating"))
+	Expect(output).To(ContainSubstring("INFO cbclient: authenticating"))
+	Expect(output).To(ContainSubstring("DEBUG cbclient: request [method POST url " + server.URL + "/api/v3/cmp/apiToken/"))
+	Expect(output).To(ContainSubstring("My Simple Blueprint"))
+
+	// Neither the password nor the token ever make it to the logger
+	Expect(output).To(ContainSubstring(`"password":"REDACTED"`))
+	Expect(output).To(ContainSubstring(`"token":"REDACTED"`))
+	Expect(output).NotTo(ContainSubstring("testPass"))
+	Expect(output).NotTo(ContainSubstring("Testing Token"))
+}
+
+func TestSetLoggerNil(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	client := New("https", "localhost", "8443", "user", "pass", "", nil)
+	client.SetLogger(&recordingLogger{})
+	client.SetLogger(nil)
+
+	Expect(client.log()).To(Equal(Logger(nopLogger{})))
+}
+
+func TestRedactBody(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	cases := map[string]string{
+		``:                                     ``,
+		`not json`:                             `<8 bytes, not JSON>`,
+		`{"name":"a"}`:                         `{"name":"a"}`,
+		`{"password":"x","username":"u"}`:      `{"password":"REDACTED","username":"u"}`,
+		`{"apiToken":"x"}`:                     `{"apiToken":"REDACTED"}`,
+		`[{"credentials":{"user":"u"}}]`:       `[{"credentials":"REDACTED"}]`,
+		`{"parameters":{"client_secret":"x"}}`: `{"parameters":{"client_secret":"REDACTED"}}`,
+		`{"items":[{"Authorization":"Bearer x"}]}`: `{"items":[{"Authorization":"REDACTED"}]}`,
+	}
+
+	for body, expected := range cases {
+		Expect(redactBody([]byte(body))).To(Equal(expected), body)
+	}
+}
@@ -1,9 +1,9 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 type EndpointsListResult struct {
@@ -13,6 +13,11 @@ type EndpointsListResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the Endpoints on this page of results.
+func (r *EndpointsListResult) Items() []MicrosoftEndpoint {
+	return r.Embedded.Endpoints
+}
+
 type MicrosoftEndpoint struct {
 	Links *struct {
 		Self       CloudBoltHALItem `json:"self,omitempty"`
@@ -29,17 +34,31 @@ type MicrosoftEndpoint struct {
 	MicrosoftVersion string `json:"microsoftVersion,omitempty"`
 }
 
-func (c *CloudBoltClient) GetMicrosoftEndpoint(name string) (*MicrosoftEndpoint, error) {
+func (c *CloudBoltClient) GetMicrosoftEndpoint(name string, filters ...*Filter) (*MicrosoftEndpoint, error) {
+	return c.GetMicrosoftEndpointWithContext(context.Background(), name, filters...)
+}
+
+// GetMicrosoftEndpointWithContext is the same as GetMicrosoftEndpoint with a caller-provided context.
+func (c *CloudBoltClient) GetMicrosoftEndpointWithContext(ctx context.Context, name string, filters ...*Filter) (*MicrosoftEndpoint, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "endpoints")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res EndpointsListResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -54,3 +73,14 @@ func (c *CloudBoltClient) GetMicrosoftEndpoint(name string) (*MicrosoftEndpoint,
 
 	return &res.Embedded.Endpoints[0], nil
 }
+
+// ListEndpoints fetches every Endpoint the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListEndpoints(opts *ListOptions) ([]MicrosoftEndpoint, error) {
+	return c.ListEndpointsWithContext(context.Background(), opts)
+}
+
+// ListEndpointsWithContext is the same as ListEndpoints with a caller-provided context.
+func (c *CloudBoltClient) ListEndpointsWithContext(ctx context.Context, opts *ListOptions) ([]MicrosoftEndpoint, error) {
+	return NewPaginator[MicrosoftEndpoint, EndpointsListResult](c, c.apiEndpoint("onefuse", "endpoints"), opts).ListAll(ctx)
+}
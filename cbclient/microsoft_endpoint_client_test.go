@@ -48,3 +48,31 @@ func TestGetMicrosoftEndpoint(t *testing.T) {
 	Expect(endpoint.SSL).To(Equal(true))
 
 }
+
+func TestListEndpoints(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListEndpoints)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	endpoints, err := client.ListEndpoints(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Endpoints, get a token
+	// 3. Successfully getting the only page of Endpoints
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/endpoints/"))
+
+	Expect(endpoints).To(HaveLen(1))
+	Expect(endpoints[0].ID).To(Equal(7))
+}
@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -78,6 +80,79 @@ func mockServer(responseFunc fn) (*httptest.Server, *mockRequests) {
 	return server, &requests
 }
 
+// mockServerWithHeaders is mockServer with extra response headers.
+// headerFunc is called with the same request index as responseFunc.
+func mockServerWithHeaders(responseFunc fn, headerFunc func(int) http.Header) (*httptest.Server, *mockRequests) {
+	var requests mockRequests
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.append(copyRequest(r))
+
+		body, status := responseFunc(len(requests) - 1)
+
+		for key, values := range headerFunc(len(requests) - 1) {
+			for _, value := range values {
+				w.Header().Add(key, value)
+			}
+		}
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+
+	return server, &requests
+}
+
+// mockAuthServer creates a server that is safe to call from many goroutines at once.
+// Token requests get anAuthRequestResponseBody after delay, to give concurrent callers
+// time to pile up. Any other request gets a 401 unless it carries that token,
+// in which case it gets responseBody.
+//
+// Returns the server and a counter of how many token requests were made.
+func mockAuthServer(responseBody string, delay time.Duration) (*httptest.Server, *int32) {
+	var logins int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+
+		if r.URL.Path == "/api/v3/cmp/apiToken/" {
+			atomic.AddInt32(&logins, 1)
+			time.Sleep(delay)
+			w.Write([]byte(anAuthRequestResponseBody))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer Testing Token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(anUnauthorizedResponseBody))
+			return
+		}
+
+		w.Write([]byte(responseBody))
+	}))
+
+	return server, &logins
+}
+
+// mockBrokenServer creates a server that accepts connections and immediately
+// drops them without writing a response, the way a crashed load balancer or
+// a network partition would.
+//
+// Returns the server and the queue of requests that reached it.
+func mockBrokenServer() (*httptest.Server, *mockRequests) {
+	var requests mockRequests
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.append(copyRequest(r))
+
+		conn, _, err := w.(http.Hijacker).Hijack()
+		Expect(err).NotTo(HaveOccurred())
+		conn.Close()
+	}))
+
+	return server, &requests
+}
+
 // bodyToString was created because I kept forgetting how
 // to get something useful out of http.Response.Body
 func bodyToString(b io.ReadCloser) string {
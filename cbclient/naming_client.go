@@ -1,10 +1,9 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
-	"net/url"
 	"strconv"
 )
 
@@ -15,14 +14,37 @@ type NamingPolicyResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the NamingPolicies on this page of results.
+func (r *NamingPolicyResult) Items() []NamingPolicy {
+	return r.Embedded.NamingPolicies
+}
+
+type CustomNameResult struct {
+	CloudBoltResult
+	Embedded struct {
+		CustomNames []CustomName `json:"customNames"`
+	} `json:"_embedded"`
+}
+
+// Items returns the Custom Names on this page of results.
+func (r *CustomNameResult) Items() []CustomName {
+	return r.Embedded.CustomNames
+}
+
 type NamingPolicy struct {
 	Links *struct {
-		Self      CloudBoltHALItem `json:"self,omitempty"`
-		Workspace CloudBoltHALItem `json:"workspace,omitempty"`
+		Self               CloudBoltHALItem   `json:"self,omitempty"`
+		Workspace          CloudBoltHALItem   `json:"workspace,omitempty"`
+		NamingSequences    []CloudBoltHALItem `json:"namingSequences,omitempty"`
+		ValidationPolicies []CloudBoltHALItem `json:"validationPolicies,omitempty"`
 	} `json:"_links,omitempty"`
-	ID          int    `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	WorkspaceURL string `json:"workspace,omitempty"`
+	// Template renders the name, e.g., "web{{sequence.web}}"
+	Template  string `json:"template,omitempty"`
+	DNSSuffix string `json:"dnsSuffix,omitempty"`
 }
 
 type CustomName struct {
@@ -31,17 +53,31 @@ type CustomName struct {
 	DnsSuffix string
 }
 
-func (c *CloudBoltClient) GetNamingPolicy(name string) (*NamingPolicy, error) {
+func (c *CloudBoltClient) GetNamingPolicy(name string, filters ...*Filter) (*NamingPolicy, error) {
+	return c.GetNamingPolicyWithContext(context.Background(), name, filters...)
+}
+
+// GetNamingPolicyWithContext is the same as GetNamingPolicy with a caller-provided context.
+func (c *CloudBoltClient) GetNamingPolicyWithContext(ctx context.Context, name string, filters ...*Filter) (*NamingPolicy, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "namingPolicies")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res NamingPolicyResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -58,10 +94,15 @@ func (c *CloudBoltClient) GetNamingPolicy(name string) (*NamingPolicy, error) {
 }
 
 func (c *CloudBoltClient) GenerateCustomName(namingPolicyID string, workspaceID string, templateProperties map[string]interface{}) (*OneFuseJobStatus, error) {
-	log.Println("onefuse.apiClient: GenerateCustomName")
+	return c.GenerateCustomNameWithContext(context.Background(), namingPolicyID, workspaceID, templateProperties)
+}
+
+// GenerateCustomNameWithContext is the same as GenerateCustomName with a caller-provided context.
+func (c *CloudBoltClient) GenerateCustomNameWithContext(ctx context.Context, namingPolicyID string, workspaceID string, templateProperties map[string]interface{}) (*OneFuseJobStatus, error) {
+	c.log().Debug("onefuse.apiClient: GenerateCustomName")
 
 	if workspaceID == "" {
-		workspace, err := c.GetDefaultWorkSpace()
+		workspace, err := c.GetDefaultWorkSpaceWithContext(ctx)
 
 		if err != nil {
 			return nil, err
@@ -84,10 +125,11 @@ func (c *CloudBoltClient) GenerateCustomName(namingPolicyID string, workspaceID
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "customNames")
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -99,13 +141,22 @@ func (c *CloudBoltClient) GenerateCustomName(namingPolicyID string, workspaceID
 }
 
 func (c *CloudBoltClient) GetCustomName(customNamePath string) (*CustomName, error) {
+	return c.GetCustomNameWithContext(context.Background(), customNamePath)
+}
+
+// GetCustomNameWithContext is the same as GetCustomName with a caller-provided context.
+func (c *CloudBoltClient) GetCustomNameWithContext(ctx context.Context, customNamePath string) (*CustomName, error) {
 	apiurl := c.baseURL
 	apiurl.Path = customNamePath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -117,13 +168,22 @@ func (c *CloudBoltClient) GetCustomName(customNamePath string) (*CustomName, err
 }
 
 func (c *CloudBoltClient) GetCustomNameById(customNameId string) (*CustomName, error) {
+	return c.GetCustomNameByIdWithContext(context.Background(), customNameId)
+}
+
+// GetCustomNameByIdWithContext is the same as GetCustomNameById with a caller-provided context.
+func (c *CloudBoltClient) GetCustomNameByIdWithContext(ctx context.Context, customNameId string) (*CustomName, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "customNames", customNameId)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -135,13 +195,19 @@ func (c *CloudBoltClient) GetCustomNameById(customNameId string) (*CustomName, e
 }
 
 func (c *CloudBoltClient) DeleteCustomName(customNameId string) (*OneFuseJobStatus, error) {
+	return c.DeleteCustomNameWithContext(context.Background(), customNameId)
+}
+
+// DeleteCustomNameWithContext is the same as DeleteCustomName with a caller-provided context.
+func (c *CloudBoltClient) DeleteCustomNameWithContext(ctx context.Context, customNameId string) (*OneFuseJobStatus, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "customNames", customNameId)
 
-	resp, err := c.makeRequest("DELETE", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "DELETE", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -151,3 +217,84 @@ func (c *CloudBoltClient) DeleteCustomName(customNameId string) (*OneFuseJobStat
 
 	return job_status, nil
 }
+
+// ListNamingPolicies fetches every Naming Policy the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListNamingPolicies(opts *ListOptions) ([]NamingPolicy, error) {
+	return c.ListNamingPoliciesWithContext(context.Background(), opts)
+}
+
+// ListNamingPoliciesWithContext is the same as ListNamingPolicies with a caller-provided context.
+func (c *CloudBoltClient) ListNamingPoliciesWithContext(ctx context.Context, opts *ListOptions) ([]NamingPolicy, error) {
+	return NewPaginator[NamingPolicy, NamingPolicyResult](c, c.apiEndpoint("onefuse", "namingPolicies"), opts).ListAll(ctx)
+}
+
+// ListCustomNames fetches every Custom Name the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., NewFilter().Policy(2).Archived(false).
+func (c *CloudBoltClient) ListCustomNames(opts *ListOptions) ([]CustomName, error) {
+	return c.ListCustomNamesWithContext(context.Background(), opts)
+}
+
+// ListCustomNamesWithContext is the same as ListCustomNames with a caller-provided context.
+func (c *CloudBoltClient) ListCustomNamesWithContext(ctx context.Context, opts *ListOptions) ([]CustomName, error) {
+	return NewPaginator[CustomName, CustomNameResult](c, c.apiEndpoint("onefuse", "customNames"), opts).ListAll(ctx)
+}
+
+// CreateNamingPolicy creates a Naming Policy in OneFuse and returns it as saved.
+// If newPolicy has no WorkspaceURL it goes in the default workspace.
+func (c *CloudBoltClient) CreateNamingPolicy(newPolicy *NamingPolicy) (*NamingPolicy, error) {
+	return c.CreateNamingPolicyWithContext(context.Background(), newPolicy)
+}
+
+// CreateNamingPolicyWithContext is the same as CreateNamingPolicy with a caller-provided context.
+func (c *CloudBoltClient) CreateNamingPolicyWithContext(ctx context.Context, newPolicy *NamingPolicy) (*NamingPolicy, error) {
+	var policy NamingPolicy
+	err := c.createPolicy(ctx, "namingPolicies", &newPolicy.WorkspaceURL, newPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// GetNamingPolicyByID fetches the Naming Policy with the given ID.
+func (c *CloudBoltClient) GetNamingPolicyByID(policyId string) (*NamingPolicy, error) {
+	return c.GetNamingPolicyByIDWithContext(context.Background(), policyId)
+}
+
+// GetNamingPolicyByIDWithContext is the same as GetNamingPolicyByID with a caller-provided context.
+func (c *CloudBoltClient) GetNamingPolicyByIDWithContext(ctx context.Context, policyId string) (*NamingPolicy, error) {
+	var policy NamingPolicy
+	err := c.getPolicy(ctx, "namingPolicies", policyId, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// UpdateNamingPolicy replaces the Naming Policy with the given ID and returns it as saved.
+func (c *CloudBoltClient) UpdateNamingPolicy(policyId string, updatedPolicy *NamingPolicy) (*NamingPolicy, error) {
+	return c.UpdateNamingPolicyWithContext(context.Background(), policyId, updatedPolicy)
+}
+
+// UpdateNamingPolicyWithContext is the same as UpdateNamingPolicy with a caller-provided context.
+func (c *CloudBoltClient) UpdateNamingPolicyWithContext(ctx context.Context, policyId string, updatedPolicy *NamingPolicy) (*NamingPolicy, error) {
+	var policy NamingPolicy
+	err := c.updatePolicy(ctx, "namingPolicies", policyId, updatedPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// DeleteNamingPolicy deletes the Naming Policy with the given ID.
+func (c *CloudBoltClient) DeleteNamingPolicy(policyId string) error {
+	return c.DeleteNamingPolicyWithContext(context.Background(), policyId)
+}
+
+// DeleteNamingPolicyWithContext is the same as DeleteNamingPolicy with a caller-provided context.
+func (c *CloudBoltClient) DeleteNamingPolicyWithContext(ctx context.Context, policyId string) error {
+	return c.deletePolicy(ctx, "namingPolicies", policyId)
+}
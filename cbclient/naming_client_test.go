@@ -177,3 +177,202 @@ func TestDeleteCustomName(t *testing.T) {
 	// The CloudBolt Order object should be parsed correctly
 	verifyJobStatus(jobStatus)
 }
+
+func TestListNamingPolicies(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListNamingPolicies)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	policies, err := client.ListNamingPolicies(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Naming Policies, get a token
+	// 3. Successfully getting the only page of Naming Policies
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/namingPolicies/"))
+
+	Expect(policies).To(HaveLen(1))
+	Expect(policies[0].ID).To(Equal(1))
+}
+
+func TestListCustomNames(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListCustomNames)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	names, err := client.ListCustomNames(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Custom Names, get a token
+	// 3. Successfully getting the only page of Custom Names
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/customNames/"))
+
+	Expect(names).To(HaveLen(1))
+	Expect(names[0].Id).To(Equal(1))
+}
+
+func TestCreateNamingPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleNamingPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	newPolicy := NamingPolicy{
+		Name:         "My_Naming_Policy",
+		Description:  "A Naming Policy",
+		WorkspaceURL: "/api/v3/onefuse/workspaces/2/",
+		Template:     "{{sequence.QA_BASE10_NamingSequence10807033}}",
+		DNSSuffix:    "test.com",
+	}
+
+	policy, err := client.CreateNamingPolicy(&newPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to create, get a token
+	// 3. Successful create
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/namingPolicies/"))
+
+	verifyNamingPolicy(policy)
+}
+
+func TestGetNamingPolicyByID(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleNamingPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	policy, err := client.GetNamingPolicyByID("1")
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get, get a token
+	// 3. Successful Get
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/namingPolicies/1/"))
+
+	verifyNamingPolicy(policy)
+}
+
+func TestUpdateNamingPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleNamingPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	updatedPolicy := NamingPolicy{
+		Name:         "My_Naming_Policy",
+		Description:  "A Naming Policy",
+		WorkspaceURL: "/api/v3/onefuse/workspaces/2/",
+		Template:     "{{sequence.QA_BASE10_NamingSequence10807033}}",
+		DNSSuffix:    "test.com",
+	}
+
+	policy, err := client.UpdateNamingPolicy("1", &updatedPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to update, get a token
+	// 3. Successful update
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("PUT"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/namingPolicies/1/"))
+
+	verifyNamingPolicy(policy)
+}
+
+func TestDeleteNamingPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleNamingPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	err := client.DeleteNamingPolicy("1")
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to delete, get a token
+	// 3. Successful delete
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("DELETE"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/namingPolicies/1/"))
+}
+
+func verifyNamingPolicy(policy *NamingPolicy) {
+	Expect(policy.Links.Self.Href).To(Equal("/api/v3/onefuse/namingPolicies/1/"))
+	Expect(policy.Links.Self.Title).To(Equal("My_Naming_Policy"))
+	Expect(policy.Links.Workspace.Href).To(Equal("/api/v3/onefuse/workspaces/2/"))
+	Expect(policy.ID).To(Equal(1))
+	Expect(policy.Name).To(Equal("My_Naming_Policy"))
+	Expect(policy.Template).To(Equal("{{sequence.QA_BASE10_NamingSequence10807033}}"))
+	Expect(policy.DNSSuffix).To(Equal("test.com"))
+	Expect(policy.Links.NamingSequences[0].Href).To(Equal("/api/v3/onefuse/namingSequences/61/"))
+}
@@ -0,0 +1,160 @@
+package cbclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Operation tracks a OneFuse job started by one of the Create*, Generate* or Delete* methods,
+// which return as soon as OneFuse has accepted the request.
+//
+// Wrap the returned job status with NewOperation, giving it the Get* method for the managed object:
+//
+//	status, err := client.CreateIPAMReservation(&reservation)
+//	if err != nil {
+//		return err
+//	}
+//	op := cbclient.NewOperation(client, status, client.GetIPAMReservationWithContext)
+//	reservation, err := op.Wait(ctx)
+//
+// For Delete* jobs, which leave nothing to fetch, pass a nil fetch function and Wait returns nil.
+//
+// An Operation made from a nil job status, e.g., because the error from Create* was ignored,
+// returns ErrNilJobStatus from Poll and Wait.
+type Operation[T any] struct {
+	client *CloudBoltClient
+	status *OneFuseJobStatus
+	fetch  func(ctx context.Context, path string) (*T, error)
+	opts   *WaitOptions
+}
+
+// ErrNilJobStatus is returned by an Operation that was given a nil job status.
+var ErrNilJobStatus = errors.New("cbclient: Operation has no job status to track")
+
+// OneFuseJobError is returned when a OneFuse job ends in the Failed state.
+type OneFuseJobError struct {
+	JobID       int
+	JobType     string
+	Description string
+	// Details holds the OneFuse error details, if the job had any.
+	Details *OneFuseErrorDetails
+	Status  *OneFuseJobStatus
+}
+
+func (e *OneFuseJobError) Error() string {
+	msg := strings.Join(e.Details.Messages(), "; ")
+	if msg == "" {
+		msg = e.Description
+	}
+
+	return fmt.Sprintf("OneFuse job %d (%s) failed: %s", e.JobID, e.JobType, msg)
+}
+
+// NewOperation returns an Operation for the job described by status.
+// fetch gets the managed object once the job succeeds, and may be nil.
+func NewOperation[T any](c *CloudBoltClient, status *OneFuseJobStatus, fetch func(ctx context.Context, path string) (*T, error)) *Operation[T] {
+	return &Operation[T]{
+		client: c,
+		status: status,
+		fetch:  fetch,
+	}
+}
+
+// SetWaitOptions sets how often Wait polls the job. Only the intervals are used; Progress is ignored.
+// Passing nil polls every 5 seconds, which is the default.
+func (op *Operation[T]) SetWaitOptions(opts *WaitOptions) {
+	op.opts = opts
+}
+
+// Status returns the job status as of the last poll.
+func (op *Operation[T]) Status() *OneFuseJobStatus {
+	return op.status
+}
+
+// Done reports whether the job had finished as of the last poll.
+// It is false for an Operation without a job status.
+func (op *Operation[T]) Done() bool {
+	return op.status != nil && op.status.JobState.IsTerminal()
+}
+
+// Poll fetches the job status once, and reports whether the job has finished.
+// A job that failed returns true along with a *OneFuseJobError.
+func (op *Operation[T]) Poll() (bool, error) {
+	return op.PollWithContext(context.Background())
+}
+
+// PollWithContext is the same as Poll with a caller-provided context.
+func (op *Operation[T]) PollWithContext(ctx context.Context) (bool, error) {
+	if op.status == nil {
+		return false, ErrNilJobStatus
+	}
+
+	if !op.Done() {
+		status, err := op.client.GetJobStatusWithContext(ctx, op.statusPath())
+		if err != nil {
+			return false, err
+		}
+		op.status = status
+	}
+
+	return op.Done(), op.err()
+}
+
+// Wait polls the job until it finishes, then fetches and returns the managed object.
+// A job that failed returns a *OneFuseJobError.
+func (op *Operation[T]) Wait(ctx context.Context) (*T, error) {
+	opts := op.opts
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+
+	for delay := opts.Interval; ; delay = opts.next(delay) {
+		done, err := op.PollWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			break
+		}
+
+		if delay <= 0 {
+			delay = defaultWaitInterval
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+
+	if op.fetch == nil || op.status.Links == nil || op.status.Links.ManagedObject.Href == "" {
+		return nil, nil
+	}
+
+	return op.fetch(ctx, op.status.Links.ManagedObject.Href)
+}
+
+// statusPath returns the path the job status is polled at.
+func (op *Operation[T]) statusPath() string {
+	if op.status.Links != nil && op.status.Links.Self.Href != "" {
+		return op.status.Links.Self.Href
+	}
+
+	return op.client.apiEndpoint("onefuse", "jobStatus", strconv.Itoa(op.status.ID))
+}
+
+// err returns a *OneFuseJobError if the job failed.
+func (op *Operation[T]) err() error {
+	if op.status.JobState != OneFuseJobStateFailed {
+		return nil
+	}
+
+	return &OneFuseJobError{
+		JobID:       op.status.ID,
+		JobType:     op.status.JobType,
+		Description: op.status.JobStateDescription,
+		Details:     op.status.ErrorDetails,
+		Status:      op.status,
+	}
+}
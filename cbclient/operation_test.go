@@ -0,0 +1,149 @@
+package cbclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// aReservationToCreate is an IPAM Reservation request that passes client-side validation.
+func aReservationToCreate() *IPAMReservation {
+	return &IPAMReservation{
+		PolicyID:     650,
+		WorkspaceURL: "/api/v3/onefuse/workspaces/1/",
+		TemplateProperties: map[string]interface{}{
+			"subnet": "10.30.32.0/24",
+		},
+	}
+}
+
+func TestOperationWait(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForOperationWait)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	status, err := client.CreateIPAMReservation(aReservationToCreate())
+	Expect(err).NotTo(HaveOccurred())
+
+	op := NewOperation(client, status, client.GetIPAMReservationWithContext)
+	op.SetWaitOptions(&WaitOptions{Interval: time.Millisecond})
+	Expect(op.Done()).To(BeFalse())
+	Expect(op.Status().JobState).To(Equal(OneFuseJobStatePending))
+
+	reservation, err := op.Wait(context.Background())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(reservation).NotTo(BeNil())
+	Expect(reservation.ID).To(Equal(10))
+	Expect(op.Done()).To(BeTrue())
+	Expect(op.Status().JobState).To(Equal(OneFuseJobStateSuccessful))
+
+	// This should have made six requests:
+	// 1+2. Fail to create, get a token
+	// 3. Successful create
+	// 4+5. Poll the job until it succeeds
+	// 6. Get the reservation it made
+	Expect(len(*requests)).To(Equal(6))
+	Expect((*requests)[3].URL.Path).To(Equal("/api/v3/onefuse/jobStatus/3281/"))
+	Expect((*requests)[4].URL.Path).To(Equal("/api/v3/onefuse/jobStatus/3281/"))
+	Expect((*requests)[5].URL.Path).To(Equal("/api/v3/onefuse/ipamReservations/10/"))
+}
+
+func TestOperationFailed(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForOperationFailed)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	status, err := client.CreateIPAMReservation(aReservationToCreate())
+	Expect(err).NotTo(HaveOccurred())
+
+	op := NewOperation(client, status, client.GetIPAMReservationWithContext)
+
+	done, err := op.Poll()
+	Expect(done).To(BeTrue())
+	Expect(len(*requests)).To(Equal(4))
+
+	var jobErr *OneFuseJobError
+	Expect(errors.As(err, &jobErr)).To(BeTrue())
+	Expect(jobErr.JobID).To(Equal(3281))
+	Expect(jobErr.Details.Code).To(Equal(500))
+	Expect(err.Error()).To(Equal("OneFuse job 3281 (Provision IPAM) failed: No free IP addresses in 10.30.32.0/24"))
+
+	// The job is done, so waiting doesn't poll again
+	reservation, err := op.Wait(context.Background())
+	Expect(reservation).To(BeNil())
+	Expect(errors.As(err, &jobErr)).To(BeTrue())
+	Expect(len(*requests)).To(Equal(4))
+}
+
+func TestOperationDelete(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForOperationDelete)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	status, err := client.DeleteIPAMReservation("10")
+	Expect(err).NotTo(HaveOccurred())
+
+	op := NewOperation[IPAMReservation](client, status, nil)
+	op.SetWaitOptions(&WaitOptions{Interval: time.Millisecond})
+
+	reservation, err := op.Wait(context.Background())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(reservation).To(BeNil())
+
+	// Nothing is fetched once the job is done
+	Expect(len(*requests)).To(Equal(4))
+	Expect((*requests)[2].Method).To(Equal("DELETE"))
+}
+
+func TestOperationNilStatus(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForOperationDelete)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// A nil status, e.g., from an ignored Create* error, doesn't panic
+	op := NewOperation(client, nil, client.GetIPAMReservationWithContext)
+	Expect(op.Status()).To(BeNil())
+	Expect(op.Done()).To(BeFalse())
+
+	done, err := op.Poll()
+	Expect(done).To(BeFalse())
+	Expect(errors.Is(err, ErrNilJobStatus)).To(BeTrue())
+
+	reservation, err := op.Wait(context.Background())
+	Expect(reservation).To(BeNil())
+	Expect(errors.Is(err, ErrNilJobStatus)).To(BeTrue())
+
+	// Nothing was sent
+	Expect(*requests).To(BeEmpty())
+}
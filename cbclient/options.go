@@ -0,0 +1,270 @@
+package cbclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultUserAgent is sent with every request unless WithUserAgent says otherwise.
+const defaultUserAgent string = "cloudbolt-go-sdk"
+
+// defaultTimeout is the http.Client timeout used when no HTTP client is provided.
+const defaultTimeout time.Duration = 60 * time.Second
+
+// Option configures a CloudBoltClient created by NewClient.
+type Option func(*clientOptions) error
+
+// clientOptions collects everything the Options ask for before the client is built,
+// since some of them (TLS, proxy, timeout) have to be combined into one http.Client.
+type clientOptions struct {
+	username      string
+	password      string
+	domain        string
+	tokenProvider TokenProvider
+	refresh       *time.Duration
+
+	httpClient *http.Client
+	tlsConfig  *tls.Config
+	rootCAs    *x509.CertPool
+	proxy      *url.URL
+	timeout    *time.Duration
+
+	userAgent   string
+	logger      Logger
+	retryPolicy *RetryPolicy
+}
+
+// WithCredentials sets the username and password used to log in at /api/v3/cmp/apiToken/.
+func WithCredentials(username string, password string) Option {
+	return func(o *clientOptions) error {
+		o.username = username
+		o.password = password
+		return nil
+	}
+}
+
+// WithDomain sets the LDAP domain sent along with the credentials.
+func WithDomain(domain string) Option {
+	return func(o *clientOptions) error {
+		o.domain = domain
+		return nil
+	}
+}
+
+// WithTokenProvider makes the client get its API tokens from provider
+// instead of logging in with WithCredentials.
+func WithTokenProvider(provider TokenProvider) Option {
+	return func(o *clientOptions) error {
+		o.tokenProvider = provider
+		return nil
+	}
+}
+
+// WithTokenRefresh turns on proactive token refresh. See CloudBoltClient.SetTokenRefresh.
+func WithTokenRefresh(window time.Duration) Option {
+	return func(o *clientOptions) error {
+		o.refresh = &window
+		return nil
+	}
+}
+
+// WithHTTPClient sets the http.Client requests are made with.
+// Passing nil keeps the default, which has a 60 second timeout.
+//
+// The TLS, proxy and timeout options apply to a copy of httpClient,
+// so it can be shared with other code without being changed.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) error {
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used to connect to CloudBolt.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *clientOptions) error {
+		if config == nil {
+			return errors.New("WithTLSConfig: config is nil")
+		}
+
+		o.tlsConfig = config.Clone()
+		return nil
+	}
+}
+
+// WithCABundle trusts the PEM encoded certificates in pem, in addition to the system roots.
+// Use it for CloudBolt installs with a certificate from a private CA.
+func WithCABundle(pem []byte) Option {
+	return func(o *clientOptions) error {
+		if o.rootCAs == nil {
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+			o.rootCAs = pool
+		}
+
+		if !o.rootCAs.AppendCertsFromPEM(pem) {
+			return errors.New("WithCABundle: no PEM certificates found")
+		}
+
+		return nil
+	}
+}
+
+// WithCABundleFile is WithCABundle with the certificates read from a file.
+func WithCABundleFile(path string) Option {
+	return func(o *clientOptions) error {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("WithCABundleFile: %w", err)
+		}
+
+		return WithCABundle(pem)(o)
+	}
+}
+
+// WithProxy sends every request through the HTTP proxy at proxyURL,
+// instead of the one from the environment (HTTPS_PROXY and friends).
+func WithProxy(proxyURL string) Option {
+	return func(o *clientOptions) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("WithProxy: %w", err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("WithProxy: %q is not an absolute URL", proxyURL)
+		}
+
+		o.proxy = u
+		return nil
+	}
+}
+
+// WithTimeout sets the time limit for each HTTP request, including reading the response.
+// Zero means no limit. Finer-grained timeouts can be set on a transport passed with WithHTTPClient.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) error {
+		if timeout < 0 {
+			return fmt.Errorf("WithTimeout: negative timeout %s", timeout)
+		}
+
+		o.timeout = &timeout
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) error {
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithLogger sets the client's Logger. See CloudBoltClient.SetLogger.
+func WithLogger(logger Logger) Option {
+	return func(o *clientOptions) error {
+		o.logger = logger
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the client's RetryPolicy. See CloudBoltClient.SetRetryPolicy.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *clientOptions) error {
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+// parseBaseURL checks that rawURL is an absolute http(s) URL and splits it into
+// the scheme and host, and a path prefix without a trailing slash.
+func parseBaseURL(rawURL string) (url.URL, string, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return url.URL{}, "", fmt.Errorf("invalid CloudBolt URL: %w", err)
+	}
+
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		return url.URL{}, "", fmt.Errorf("invalid CloudBolt URL %q: scheme must be http or https", rawURL)
+	case u.Host == "" || u.Hostname() == "":
+		return url.URL{}, "", fmt.Errorf("invalid CloudBolt URL %q: missing host", rawURL)
+	case u.User != nil:
+		return url.URL{}, "", fmt.Errorf("invalid CloudBolt URL %q: use WithCredentials instead of credentials in the URL", rawURL)
+	case u.RawQuery != "" || u.Fragment != "":
+		return url.URL{}, "", fmt.Errorf("invalid CloudBolt URL %q: must not have a query or fragment", rawURL)
+	}
+
+	if port := u.Port(); port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return url.URL{}, "", fmt.Errorf("invalid CloudBolt URL %q: bad port %q", rawURL, port)
+		}
+	}
+
+	baseURL := url.URL{
+		Scheme: u.Scheme,
+		Host:   u.Host,
+	}
+
+	return baseURL, strings.TrimRight(u.Path, "/"), nil
+}
+
+// buildHTTPClient returns the http.Client described by the options.
+func (o *clientOptions) buildHTTPClient() (*http.Client, error) {
+	if o.httpClient == nil {
+		o.httpClient = &http.Client{
+			Timeout: defaultTimeout,
+		}
+	}
+
+	if o.tlsConfig == nil && o.rootCAs == nil && o.proxy == nil && o.timeout == nil {
+		// Nothing to change, so the caller's client is used as-is
+		return o.httpClient, nil
+	}
+
+	client := *o.httpClient
+	if o.timeout != nil {
+		client.Timeout = *o.timeout
+	}
+
+	if o.tlsConfig == nil && o.rootCAs == nil && o.proxy == nil {
+		return &client, nil
+	}
+
+	var transport *http.Transport
+	switch t := client.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, fmt.Errorf("TLS and proxy options need an *http.Transport, the HTTP client has a %T", t)
+	}
+
+	if o.tlsConfig != nil {
+		transport.TLSClientConfig = o.tlsConfig
+	}
+	if o.rootCAs != nil {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.RootCAs = o.rootCAs
+	}
+	if o.proxy != nil {
+		transport.Proxy = http.ProxyURL(o.proxy)
+	}
+
+	client.Transport = transport
+
+	return &client, nil
+}
@@ -0,0 +1,228 @@
+package cbclient
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewClientValidatesURL(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	valid := map[string]string{
+		"https://cloudbolt.intranet":              "",
+		"https://cloudbolt.intranet:8443/":        "",
+		"http://10.0.0.1":                         "",
+		"https://[::1]:443":                       "",
+		"https://proxy.intranet/cloudbolt":        "/cloudbolt",
+		"https://proxy.intranet/tools/cloudbolt/": "/tools/cloudbolt",
+		" https://cloudbolt.intranet ":            "",
+	}
+	for baseURL, prefix := range valid {
+		client, err := NewClient(baseURL)
+		Expect(err).NotTo(HaveOccurred(), baseURL)
+		Expect(client.pathPrefix).To(Equal(prefix), baseURL)
+		Expect(client.baseURL.Path).To(BeEmpty(), baseURL)
+	}
+
+	invalid := map[string]string{
+		"":                                   "scheme must be http or https",
+		"cloudbolt.intranet":                 "scheme must be http or https",
+		"ftp://cloudbolt.intranet":           "scheme must be http or https",
+		"https://":                           "missing host",
+		"https://:443":                       "missing host",
+		"https://cloudbolt.intranet:99999":   "bad port",
+		"https://cloudbolt.intranet:http":    "invalid CloudBolt URL",
+		"https://user:pw@cloudbolt.intranet": "use WithCredentials",
+		"https://cloudbolt.intranet/?a=b":    "must not have a query",
+		"https://cloudbolt.intranet/#top":    "must not have a query",
+		"https://cloudbolt intranet":         "invalid CloudBolt URL",
+	}
+	for baseURL, message := range invalid {
+		client, err := NewClient(baseURL)
+		Expect(client).To(BeNil(), baseURL)
+		Expect(err).To(MatchError(ContainSubstring(message)), baseURL)
+	}
+}
+
+func TestNewClientPathPrefix(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForBlueprintById)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client, err := NewClient(
+		server.URL+"/cloudbolt/",
+		WithCredentials("testUser", "testPass"),
+		WithDomain("mydomain.com"),
+	)
+	Expect(err).NotTo(HaveOccurred())
+
+	blueprint, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprint.ID).To(Equal("BP-esnjtp7u"))
+
+	// Every request, including the login, goes through the prefix
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[0].URL.Path).To(Equal("/cloudbolt/api/v3/cmp/blueprints/BP-esnjtp7u/"))
+	Expect((*requests)[1].URL.Path).To(Equal("/cloudbolt/api/v3/cmp/apiToken/"))
+	Expect((*requests)[2].URL.Path).To(Equal("/cloudbolt/api/v3/cmp/blueprints/BP-esnjtp7u/"))
+	Expect(bodyToString((*requests)[1].Body)).To(MatchJSON(`{"username": "testUser", "password": "testPass", "domain": "mydomain.com"}`))
+
+	// The default User-Agent identifies the SDK
+	Expect((*requests)[2].Header.Get("User-Agent")).To(Equal("cloudbolt-go-sdk"))
+
+	// Paths that already have the prefix aren't prefixed twice
+	prefixed, err := client.prefixURL(server.URL + "/cloudbolt/api/v3/cmp/orders/ORD-1/")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(prefixed).To(Equal(server.URL + "/cloudbolt/api/v3/cmp/orders/ORD-1/"))
+}
+
+func TestNewClientUserAgent(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForBlueprintById)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client, err := NewClient(server.URL, WithCredentials("testUser", "testPass"), WithUserAgent("my-tool/1.2"))
+	Expect(err).NotTo(HaveOccurred())
+
+	_, err = client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+
+	for _, r := range *requests {
+		Expect(r.Header.Get("User-Agent")).To(Equal("my-tool/1.2"))
+	}
+}
+
+func TestNewClientHTTPOptions(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// The default client has the same timeout as New
+	client, err := NewClient("https://cloudbolt.intranet")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(client.httpClient.Timeout).To(Equal(60 * time.Second))
+
+	// A caller's client is used as-is when nothing needs changing
+	httpClient := &http.Client{Timeout: time.Second}
+	client, err = NewClient("https://cloudbolt.intranet", WithHTTPClient(httpClient))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(client.httpClient).To(BeIdenticalTo(httpClient))
+
+	// ... and copied when something does
+	client, err = NewClient(
+		"https://cloudbolt.intranet",
+		WithHTTPClient(httpClient),
+		WithTimeout(5*time.Second),
+		WithProxy("http://proxy.intranet:3128"),
+		WithTLSConfig(&tls.Config{ServerName: "cloudbolt"}),
+	)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(client.httpClient).NotTo(BeIdenticalTo(httpClient))
+	Expect(client.httpClient.Timeout).To(Equal(5 * time.Second))
+	Expect(httpClient.Timeout).To(Equal(time.Second))
+	Expect(httpClient.Transport).To(BeNil())
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	Expect(ok).To(BeTrue())
+	Expect(transport.TLSClientConfig.ServerName).To(Equal("cloudbolt"))
+
+	req, err := http.NewRequest("GET", "https://cloudbolt.intranet/", nil)
+	Expect(err).NotTo(HaveOccurred())
+	proxyURL, err := transport.Proxy(req)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(proxyURL).To(Equal(&url.URL{Scheme: "http", Host: "proxy.intranet:3128"}))
+
+	// Transport options can't be applied to a RoundTripper we don't know
+	_, err = NewClient(
+		"https://cloudbolt.intranet",
+		WithHTTPClient(&http.Client{Transport: http.NewFileTransport(http.Dir("."))}),
+		WithProxy("http://proxy.intranet:3128"),
+	)
+	Expect(err).To(MatchError(ContainSubstring("need an *http.Transport")))
+
+	// Bad option values are reported by NewClient
+	_, err = NewClient("https://cloudbolt.intranet", WithProxy("proxy.intranet"))
+	Expect(err).To(MatchError(ContainSubstring("not an absolute URL")))
+	_, err = NewClient("https://cloudbolt.intranet", WithTimeout(-time.Second))
+	Expect(err).To(MatchError(ContainSubstring("negative timeout")))
+	_, err = NewClient("https://cloudbolt.intranet", WithTLSConfig(nil))
+	Expect(err).To(MatchError(ContainSubstring("config is nil")))
+	_, err = NewClient("https://cloudbolt.intranet", WithCABundle([]byte("not a certificate")))
+	Expect(err).To(MatchError(ContainSubstring("no PEM certificates found")))
+	_, err = NewClient("https://cloudbolt.intranet", WithCABundleFile(filepath.Join(t.TempDir(), "missing.pem")))
+	Expect(err).To(MatchError(ContainSubstring("WithCABundleFile")))
+}
+
+func TestNewClientCABundle(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.Write([]byte(aBlueprint))
+	}))
+	defer server.Close()
+
+	// Without the test server's CA the certificate is rejected
+	client, err := NewClient(server.URL, WithTokenProvider(StaticToken("Static Token")))
+	Expect(err).NotTo(HaveOccurred())
+	_, err = client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).To(MatchError(ContainSubstring("certificate")))
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	Expect(os.WriteFile(caFile, caPEM, 0600)).To(Succeed())
+
+	client, err = NewClient(server.URL, WithTokenProvider(StaticToken("Static Token")), WithCABundleFile(caFile))
+	Expect(err).NotTo(HaveOccurred())
+
+	blueprint, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprint.ID).To(Equal("BP-esnjtp7u"))
+}
+
+func TestNewClientClientOptions(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	logger := &recordingLogger{}
+	policy := DefaultRetryPolicy()
+	provider := StaticToken("Static Token")
+
+	client, err := NewClient(
+		"https://cloudbolt.intranet",
+		WithLogger(logger),
+		WithRetryPolicy(policy),
+		WithTokenProvider(provider),
+		WithTokenRefresh(time.Minute),
+	)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(client.logger).To(BeIdenticalTo(logger))
+	Expect(client.retryPolicy).To(BeIdenticalTo(policy))
+	Expect(client.tokenProvider).To(BeIdenticalTo(provider))
+	Expect(client.proactiveRefresh).To(BeTrue())
+	Expect(client.refreshWindow).To(Equal(time.Minute))
+
+	// With no credentials at all, requests go out unauthenticated
+	client, err = NewClient("https://cloudbolt.intranet")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(client.tokenProvider).To(BeNil())
+	Expect(client.logger).To(Equal(Logger(nopLogger{})))
+}
@@ -1,10 +1,16 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
 )
 
+// ErrNoOrderInResponse is returned by DuplicateOrder when CloudBolt accepted the request
+// but its response doesn't describe the new Order. The copy was still made, so don't retry.
+var ErrNoOrderInResponse = errors.New("cbclient: CloudBolt accepted the order action but returned no order")
+
 type CloudBoltOrder struct {
 	Links struct {
 		Self       CloudBoltHALItem   `json:"self"`
@@ -14,42 +20,76 @@ type CloudBoltOrder struct {
 		Jobs       []CloudBoltHALItem `json:"jobs"`
 		Duplicate  CloudBoltHALItem   `json:"duplicate"`
 	} `json:"_links"`
-	Name            string `json:"name"`
-	ID              string `json:"id"`
-	Status          string `json:"status"`
-	Rate            string `json:"rate"`
-	CreateDate      string `json:"createDate"`
-	ApproveDate     string `json:"approveDate"`
-	DeploymentItems []struct {
-		ID                 string                 `json:"id"`
-		ResourceName       string                 `json:"resourceName"`
-		ResourceParameters map[string]interface{} `json:"resourceParameters"`
-		Blueprint          struct {
-			Href  string `json:"href"`
-			Title string `json:"title"`
-		} `json:"blueprint"`
-		BlueprintItemsArguments map[string]interface{} `json:"blueprintItemsArguments"`
-		ItemType                string                 `json:"itemType"`
-	} `json:"deploymentItems"`
+	Name            string        `json:"name"`
+	ID              string        `json:"id"`
+	Status          OrderStatus   `json:"status"`
+	Rate            string        `json:"rate"`
+	CreateDate      CloudBoltTime `json:"createDate"`
+	ApproveDate     CloudBoltTime `json:"approveDate"`
+	DeploymentItems []OrderItem   `json:"deploymentItems"`
+}
+
+// OrderItem is one of the items of an Order, e.g., the deployment of a Blueprint.
+type OrderItem struct {
+	ID                 string                 `json:"id"`
+	ResourceName       string                 `json:"resourceName"`
+	ResourceParameters map[string]interface{} `json:"resourceParameters"`
+	Blueprint          CloudBoltHALItem       `json:"blueprint"`
+	// BlueprintItemsArguments are what was ordered for each build item, by build item name,
+	// e.g., "server-bdi-743tlxxu".
+	BlueprintItemsArguments map[string]OrderItemArguments `json:"blueprintItemsArguments"`
+	ItemType                string                        `json:"itemType"`
+}
+
+// OrderItemArguments are what was ordered for one build item of a Blueprint.
+type OrderItemArguments struct {
+	TierType    string                 `json:"tierType"`
+	Parameters  map[string]interface{} `json:"parameters"`
+	Environment CloudBoltHALItem       `json:"environment"`
+	OSBuild     CloudBoltHALItem       `json:"osBuild"`
+	Attributes  struct {
+		Quantity int `json:"quantity"`
+	} `json:"attributes"`
+}
+
+type CloudBoltOrderResult struct {
+	CloudBoltResult
+	Embedded struct {
+		Orders []CloudBoltOrder `json:"orders"`
+	} `json:"_embedded"`
+}
+
+// Items returns the Orders on this page of results.
+func (r *CloudBoltOrderResult) Items() []CloudBoltOrder {
+	return r.Embedded.Orders
 }
 
 type CloudBoltOrderStatus struct {
-	Status         string   `json:"status"`
-	OutputMessages []string `json:"outputMessages"`
-	ErrorMessages  []string `json:"errorMessages"`
-	ProgressMessages  []string `json:"progressMessages"`
+	Status           OrderStatus `json:"status"`
+	OutputMessages   []string    `json:"outputMessages"`
+	ErrorMessages    []string    `json:"errorMessages"`
+	ProgressMessages []string    `json:"progressMessages"`
 }
 
 // GetOrder fetches an Order from CloudBolt
 // - Order ID (orderID) e.g., "123"; formatted into a string like "/api/v2/orders/123"
 func (c *CloudBoltClient) GetOrder(orderID string) (*CloudBoltOrder, error) {
+	return c.GetOrderWithContext(context.Background(), orderID)
+}
+
+// GetOrderWithContext is the same as GetOrder with a caller-provided context.
+func (c *CloudBoltClient) GetOrderWithContext(ctx context.Context, orderID string) (*CloudBoltOrder, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "orders", orderID)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -61,13 +101,22 @@ func (c *CloudBoltClient) GetOrder(orderID string) (*CloudBoltOrder, error) {
 }
 
 func (c *CloudBoltClient) GetOrderStatus(orderID string) (*CloudBoltOrderStatus, error) {
+	return c.GetOrderStatusWithContext(context.Background(), orderID)
+}
+
+// GetOrderStatusWithContext is the same as GetOrderStatus with a caller-provided context.
+func (c *CloudBoltClient) GetOrderStatusWithContext(ctx context.Context, orderID string) (*CloudBoltOrderStatus, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "orders", orderID, "status")
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -77,3 +126,115 @@ func (c *CloudBoltClient) GetOrderStatus(orderID string) (*CloudBoltOrderStatus,
 
 	return &orderStatus, nil
 }
+
+// ListOrders fetches every Order the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by status, group or owner:
+//
+//	orders, err := client.ListOrders(&cbclient.ListOptions{
+//		Filter: cbclient.NewFilter().Status(cbclient.OrderStatusPending).Group("GRP-yfbbsfht"),
+//	})
+func (c *CloudBoltClient) ListOrders(opts *ListOptions) ([]CloudBoltOrder, error) {
+	return c.ListOrdersWithContext(context.Background(), opts)
+}
+
+// ListOrdersWithContext is the same as ListOrders with a caller-provided context.
+func (c *CloudBoltClient) ListOrdersWithContext(ctx context.Context, opts *ListOptions) ([]CloudBoltOrder, error) {
+	return NewPaginator[CloudBoltOrder, CloudBoltOrderResult](c, c.apiEndpoint("cmp", "orders"), opts).ListAll(ctx)
+}
+
+// ApproveOrder approves an Order that is waiting for approval, and returns it.
+// - Order ID (orderID) e.g., "ORD-e9v87uia"
+//
+// If CloudBolt's response to ApproveOrder, DenyOrder or CancelOrder doesn't describe the Order,
+// the Order is fetched again. An error from that fetch is returned, wrapped, even though the action
+// itself went through.
+func (c *CloudBoltClient) ApproveOrder(orderID string) (*CloudBoltOrder, error) {
+	return c.ApproveOrderWithContext(context.Background(), orderID)
+}
+
+// ApproveOrderWithContext is the same as ApproveOrder with a caller-provided context.
+func (c *CloudBoltClient) ApproveOrderWithContext(ctx context.Context, orderID string) (*CloudBoltOrder, error) {
+	return c.postOrderAction(ctx, orderID, c.apiEndpoint("cmp", "orders", orderID, "approve"), nil)
+}
+
+// DenyOrder denies an Order that is waiting for approval, and returns it.
+// The reason is shown to the Order's owner, and may be empty.
+func (c *CloudBoltClient) DenyOrder(orderID string, reason string) (*CloudBoltOrder, error) {
+	return c.DenyOrderWithContext(context.Background(), orderID, reason)
+}
+
+// DenyOrderWithContext is the same as DenyOrder with a caller-provided context.
+func (c *CloudBoltClient) DenyOrderWithContext(ctx context.Context, orderID string, reason string) (*CloudBoltOrder, error) {
+	reqJSON, err := json.Marshal(map[string]string{"reason": reason})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.postOrderAction(ctx, orderID, c.apiEndpoint("cmp", "orders", orderID, "deny"), reqJSON)
+}
+
+// CancelOrder cancels an Order that hasn't finished, and returns it.
+func (c *CloudBoltClient) CancelOrder(orderID string) (*CloudBoltOrder, error) {
+	return c.CancelOrderWithContext(context.Background(), orderID)
+}
+
+// CancelOrderWithContext is the same as CancelOrder with a caller-provided context.
+func (c *CloudBoltClient) CancelOrderWithContext(ctx context.Context, orderID string) (*CloudBoltOrder, error) {
+	return c.postOrderAction(ctx, orderID, c.apiEndpoint("cmp", "orders", orderID, "cancel"), nil)
+}
+
+// DuplicateOrder submits a copy of an Order, and returns the new Order.
+// It follows the Order's duplicate link when it has one.
+//
+// If CloudBolt's response doesn't describe the new Order, DuplicateOrder returns ErrNoOrderInResponse.
+// The copy was still made then, so don't retry it, or you'll get another one.
+func (c *CloudBoltClient) DuplicateOrder(order *CloudBoltOrder) (*CloudBoltOrder, error) {
+	return c.DuplicateOrderWithContext(context.Background(), order)
+}
+
+// DuplicateOrderWithContext is the same as DuplicateOrder with a caller-provided context.
+func (c *CloudBoltClient) DuplicateOrderWithContext(ctx context.Context, order *CloudBoltOrder) (*CloudBoltOrder, error) {
+	path := order.Links.Duplicate.Href
+	if path == "" {
+		path = c.apiEndpoint("cmp", "orders", order.ID, "duplicate")
+	}
+
+	return c.postOrderAction(ctx, "", path, nil)
+}
+
+// postOrderAction POSTs body to one of the actions of an Order, and returns the Order in the response.
+// If the response doesn't describe an Order, the Order with orderID is fetched instead,
+// or ErrNoOrderInResponse is returned if orderID is empty.
+func (c *CloudBoltClient) postOrderAction(ctx context.Context, orderID string, path string, body []byte) (*CloudBoltOrder, error) {
+	apiurl := c.baseURL
+	apiurl.Path = path
+
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var order CloudBoltOrder
+	if decodeIfPresent(resp.Body, &order) && order.ID != "" {
+		return &order, nil
+	}
+
+	// The action went through, but the response doesn't say what the Order looks like now
+	c.log().Warn("cbclient: order action returned no order", "url", apiurl.String(), "status", resp.StatusCode)
+	if orderID == "" {
+		return nil, ErrNoOrderInResponse
+	}
+
+	fetched, err := c.GetOrderWithContext(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching order %s after %s: %w", orderID, path, err)
+	}
+
+	return fetched, nil
+}
@@ -1,6 +1,10 @@
 package cbclient
 
 import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -53,7 +57,7 @@ func TestGetOrder(t *testing.T) {
 	Expect(order.Links.Duplicate.Title).To(Equal("Duplicate Order"))
 	Expect(order.Name).To(Equal("Installation of My Simple Blueprint"))
 	Expect(order.ID).To(Equal("ORD-e9v87uia"))
-	Expect(order.Status).To(Equal("SUCCESS"))
+	Expect(order.Status).To(Equal(OrderStatusSuccess))
 	Expect(order.Rate).To(Equal("4.18/month"))
 	Expect(len(order.DeploymentItems)).To(Equal(1))
 	Expect(order.DeploymentItems[0].ID).To(Equal("OI-1p0bajs6"))
@@ -63,6 +67,14 @@ func TestGetOrder(t *testing.T) {
 	Expect(order.DeploymentItems[0].Blueprint.Title).To(Equal("My Simple Blueprint"))
 	Expect(order.DeploymentItems[0].BlueprintItemsArguments).To(Not(BeNil()))
 	Expect(order.DeploymentItems[0].ItemType).To(Equal("blueprint"))
+
+	// The build item arguments should be typed
+	serverArgs := order.DeploymentItems[0].BlueprintItemsArguments["server-bdi-743tlxxu"]
+	Expect(serverArgs.TierType).To(Equal("server"))
+	Expect(serverArgs.Parameters["instanceType"]).To(Equal("t2.nano"))
+	Expect(serverArgs.Environment.Href).To(Equal("/api/v3/cmp/environments/ENV-1tytr2pu/"))
+	Expect(serverArgs.Attributes.Quantity).To(Equal(1))
+	Expect(order.DeploymentItems[0].BlueprintItemsArguments["plugin-bdi-olk0xwve"].TierType).To(Equal("plugin"))
 }
 
 
@@ -94,11 +106,221 @@ func TestGetOrderStatus(t *testing.T) {
 	// 3. Successfully getting the order
 	Expect(len(*requests)).To(Equal(3))
 
-	Expect(orderStatus.Status).To(Equal("FAILURE"))
+	Expect(orderStatus.Status).To(Equal(OrderStatusFailure))
 	Expect(len(orderStatus.OutputMessages)).To(Equal(2))
 	Expect(orderStatus.OutputMessages[0]).To(Equal("Job 101: Output for Job 101"))
 	Expect(orderStatus.OutputMessages[1]).To(Equal("Job 102: Output for Job 102"))
 	Expect(len(orderStatus.ErrorMessages)).To(Equal(2))
 	Expect(orderStatus.ErrorMessages[0]).To(Equal("Job 101: Error for Job 101"))
 	Expect(orderStatus.ErrorMessages[1]).To(Equal("Job 102: Error for Job 102"))
-}
\ No newline at end of file
+}
+
+func TestListOrders(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListOrders)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	orders, err := client.ListOrders(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Orders, get a token
+	// 3. Successfully getting the only page of Orders
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/orders/"))
+
+	Expect(orders).To(HaveLen(1))
+	Expect(orders[0].ID).To(Equal("ORD-e9v87uia"))
+}
+
+func TestListOrdersWithFilter(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListOrders)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	orders, err := client.ListOrders(&ListOptions{
+		Filter: NewFilter().Status(OrderStatusPending, OrderStatusActive).Group("GRP-yfbbsfht").Owner("user001"),
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(orders).To(HaveLen(1))
+
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/orders/"))
+	Expect((*requests)[2].URL.Query().Get("filter")).To(Equal("status__in:PENDING,ACTIVE;group__global_id:GRP-yfbbsfht;owner__user__username:user001"))
+}
+
+func TestApproveOrder(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForApproveOrder)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	order, err := client.ApproveOrder("ORD-e9v87uia")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(order.Status).To(Equal(OrderStatusActive))
+
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/orders/ORD-e9v87uia/approve/"))
+}
+
+func TestDenyOrder(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForDenyOrder)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	order, err := client.DenyOrder("ORD-e9v87uia", "Over budget")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(order.Status).To(Equal(OrderStatusDenied))
+
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/orders/ORD-e9v87uia/deny/"))
+
+	// The reason should be sent in the body
+	body, err := io.ReadAll((*requests)[2].Body)
+	Expect(err).NotTo(HaveOccurred())
+	var denyReq map[string]string
+	Expect(json.Unmarshal(body, &denyReq)).To(Succeed())
+	Expect(denyReq).To(Equal(map[string]string{"reason": "Over budget"}))
+}
+
+func TestCancelOrder(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForCancelOrder)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	order, err := client.CancelOrder("ORD-e9v87uia")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(order.Status).To(Equal(OrderStatusCanceled))
+
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/orders/ORD-e9v87uia/cancel/"))
+}
+
+func TestDuplicateOrder(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForDuplicateOrder)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	var original CloudBoltOrder
+	Expect(json.Unmarshal([]byte(anOrder), &original)).To(Succeed())
+
+	order, err := client.DuplicateOrder(&original)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(order.ID).To(Equal("ORD-k2d8s1xa"))
+	Expect(order.Status).To(Equal(OrderStatusPending))
+
+	// The duplicate link of the original Order should have been followed
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/orders/ORD-e9v87uia/duplicate/"))
+}
+
+func TestOrderActionsWithoutOrder(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// A 204 No Content is a success, and the Order is fetched instead
+	server, requests := mockServer(responsesForApproveOrderNoContent)
+	client := getClient(server)
+
+	order, err := client.ApproveOrder("ORD-e9v87uia")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(order.Status).To(Equal(OrderStatusActive))
+
+	Expect(len(*requests)).To(Equal(4))
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[3].Method).To(Equal("GET"))
+	Expect((*requests)[3].URL.Path).To(Equal("/api/v3/cmp/orders/ORD-e9v87uia/"))
+
+	// So is a JSON response that isn't an Order
+	server, requests = mockServer(responsesForApproveOrderDetail)
+	client = getClient(server)
+
+	order, err = client.ApproveOrder("ORD-e9v87uia")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(order.ID).To(Equal("ORD-e9v87uia"))
+	Expect(order.Status).To(Equal(OrderStatusActive))
+
+	Expect(len(*requests)).To(Equal(4))
+	Expect((*requests)[3].Method).To(Equal("GET"))
+	Expect((*requests)[3].URL.Path).To(Equal("/api/v3/cmp/orders/ORD-e9v87uia/"))
+
+	// If fetching the Order fails, that's the error returned
+	server, requests = mockServer(responsesForCancelOrderUnreadable)
+	client = getClient(server)
+
+	order, err = client.CancelOrder("ORD-e9v87uia")
+	Expect(order).To(BeNil())
+	Expect(err).To(HaveOccurred())
+
+	var apiErr *APIError
+	Expect(errors.As(err, &apiErr)).To(BeTrue())
+	Expect(apiErr.StatusCode).To(Equal(http.StatusInternalServerError))
+	Expect(len(*requests)).To(Equal(4))
+
+	// A duplicated Order can't be fetched without its ID, but it was still created
+	server, requests = mockServer(responsesForDuplicateOrderNoContent)
+	client = getClient(server)
+
+	order, err = client.DuplicateOrder(&CloudBoltOrder{ID: "ORD-e9v87uia"})
+	Expect(order).To(BeNil())
+	Expect(errors.Is(err, ErrNoOrderInResponse)).To(BeTrue())
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/orders/ORD-e9v87uia/duplicate/"))
+}
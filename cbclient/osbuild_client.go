@@ -1,9 +1,9 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 type CloudBoltOSBuildResult struct {
@@ -13,19 +13,37 @@ type CloudBoltOSBuildResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the OSBuilds on this page of results.
+func (r *CloudBoltOSBuildResult) Items() []CloudBoltReferenceFields {
+	return r.Embedded.OSBuilds
+}
+
 // GetOSBuild accepts the name of a OSBuild
-//
-func (c *CloudBoltClient) GetOSBuild(name string) (*CloudBoltReferenceFields, error) {
+func (c *CloudBoltClient) GetOSBuild(name string, filters ...*Filter) (*CloudBoltReferenceFields, error) {
+	return c.GetOSBuildWithContext(context.Background(), name, filters...)
+}
+
+// GetOSBuildWithContext is the same as GetOSBuild with a caller-provided context.
+func (c *CloudBoltClient) GetOSBuildWithContext(ctx context.Context, name string, filters ...*Filter) (*CloudBoltReferenceFields, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "osBuilds")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	// TODO: HTTP Response handling
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 	// We Decode the data because we already have an io.Reader on hand
 	var res CloudBoltOSBuildResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -41,6 +59,11 @@ func (c *CloudBoltClient) GetOSBuild(name string) (*CloudBoltReferenceFields, er
 }
 
 func (c *CloudBoltClient) GetOSBuildById(id string) (*CloudBoltReferenceFields, error) {
+	return c.GetOSBuildByIdWithContext(context.Background(), id)
+}
+
+// GetOSBuildByIdWithContext is the same as GetOSBuildById with a caller-provided context.
+func (c *CloudBoltClient) GetOSBuildByIdWithContext(ctx context.Context, id string) (*CloudBoltReferenceFields, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint(
 		"cmp",
@@ -48,15 +71,34 @@ func (c *CloudBoltClient) GetOSBuildById(id string) (*CloudBoltReferenceFields,
 		id,
 	)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	// TODO: HTTP Response handling
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 	// We Decode the data because we already have an io.Reader on hand
 	var res CloudBoltReferenceFields
 	json.NewDecoder(resp.Body).Decode(&res)
 
 	return &res, nil
 }
+
+// ListOSBuilds fetches every OS Build the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by name or OS family:
+//
+//	builds, err := client.ListOSBuilds(&cbclient.ListOptions{
+//		Filter: cbclient.NewFilter().Eq("os_family__name", "CentOS"),
+//	})
+func (c *CloudBoltClient) ListOSBuilds(opts *ListOptions) ([]CloudBoltReferenceFields, error) {
+	return c.ListOSBuildsWithContext(context.Background(), opts)
+}
+
+// ListOSBuildsWithContext is the same as ListOSBuilds with a caller-provided context.
+func (c *CloudBoltClient) ListOSBuildsWithContext(ctx context.Context, opts *ListOptions) ([]CloudBoltReferenceFields, error) {
+	return NewPaginator[CloudBoltReferenceFields, CloudBoltOSBuildResult](c, c.apiEndpoint("cmp", "osBuilds"), opts).ListAll(ctx)
+}
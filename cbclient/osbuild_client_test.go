@@ -73,3 +73,31 @@ func TestGetOSBuildById(t *testing.T) {
 	Expect(osb.Name).To(Equal("amzn2-ami-hvm-2.0.20210721.2-x86_64-gp2"))
 	Expect(osb.ID).To(Equal("OSB-z69hjvki"))
 }
+
+func TestListOSBuilds(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListOSBuilds)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	osBuilds, err := client.ListOSBuilds(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the OS Builds, get a token
+	// 3. Successfully getting the only page of OS Builds
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/osBuilds/"))
+
+	Expect(osBuilds).To(HaveLen(1))
+	Expect(osBuilds[0].ID).To(Equal("OSB-z69hjvki"))
+}
@@ -0,0 +1,245 @@
+package cbclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// ResultPage is one page of a list endpoint. Every *Result type in this package
+// implements it, e.g., *CloudBoltBlueprintResult is a ResultPage[CloudBoltReferenceFields].
+type ResultPage[T any] interface {
+	// Items returns the objects embedded in the page.
+	Items() []T
+	// PageInfo returns the links and counts of the page.
+	PageInfo() *CloudBoltResult
+}
+
+// ListOptions controls the requests a Paginator makes.
+type ListOptions struct {
+	// PageSize is the number of objects to ask for per page. Zero leaves it to the server.
+	PageSize int
+	// Filter selects, orders and trims the objects listed.
+	Filter *Filter
+	// Query holds any other query parameters to send with the first page.
+	Query url.Values
+}
+
+// Paginator walks through every page of a CloudBolt list endpoint.
+// It follows the `next` link of each page. If the server doesn't send one but
+// the `total` says there is more, it asks for the following page number instead.
+//
+// Use Next and Item to go object by object:
+//
+//	p := cbclient.NewPaginator[cbclient.CloudBoltReferenceFields, cbclient.CloudBoltBlueprintResult](client, "/api/v3/cmp/blueprints/", nil)
+//	for p.Next(ctx) {
+//		fmt.Println(p.Item().Name)
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+//
+// or NextPage to go page by page, or ListAll to get everything at once.
+// A Paginator is not safe for concurrent use.
+type Paginator[T any] struct {
+	client  *CloudBoltClient
+	next    string
+	query   url.Values
+	page    int
+	newPage func() ResultPage[T]
+
+	items   []T
+	index   int
+	fetched int
+	total   int
+	err     error
+}
+
+// NewPaginator returns a Paginator over the list endpoint at path, e.g., "/api/v3/cmp/blueprints/".
+// T is the type of the listed objects and R the *Result type of the endpoint:
+//
+//	cbclient.NewPaginator[cbclient.CloudBoltServer, cbclient.CloudBoltServerResult](client, path, opts)
+//
+// No request is made until the first page is needed.
+// If opts has a Filter that can't be sent, its error is returned by the first NextPage.
+func NewPaginator[T any, R any, PR interface {
+	*R
+	ResultPage[T]
+}](c *CloudBoltClient, path string, opts *ListOptions) *Paginator[T] {
+	query := url.Values{}
+	var err error
+	if opts != nil {
+		for key, values := range opts.Query {
+			query[key] = append([]string(nil), values...)
+		}
+		for key, values := range opts.Filter.Values() {
+			query[key] = values
+		}
+		err = opts.Filter.Err()
+		if opts.PageSize > 0 {
+			query.Set("page_size", strconv.Itoa(opts.PageSize))
+		}
+	}
+
+	apiurl := c.baseURL
+	apiurl.Path = path
+	apiurl.RawQuery = encodeQuery(query)
+
+	return &Paginator[T]{
+		client: c,
+		next:   apiurl.String(),
+		query:  query,
+		page:   1,
+		index:  -1,
+		err:    err,
+		newPage: func() ResultPage[T] {
+			return PR(new(R))
+		},
+	}
+}
+
+// HasMore reports whether there are pages left to fetch.
+func (p *Paginator[T]) HasMore() bool {
+	return p.next != "" && p.err == nil
+}
+
+// NextPage fetches the next page and returns its objects.
+// It returns an empty slice and no error once there are no pages left.
+func (p *Paginator[T]) NextPage(ctx context.Context) ([]T, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.next == "" {
+		return []T{}, nil
+	}
+
+	page, err := p.fetch(ctx, p.next)
+	if err != nil {
+		p.err = err
+		return nil, err
+	}
+
+	info := page.PageInfo()
+	items := page.Items()
+	p.fetched += len(items)
+	p.total = info.Total
+	p.page++
+
+	switch {
+	case info.Links.Next != nil && info.Links.Next.Href != "":
+		p.next, err = p.resolve(info.Links.Next.Href)
+		if err != nil {
+			p.err = err
+			return nil, err
+		}
+	case len(items) > 0 && p.fetched < info.Total:
+		// No next link, but the total says there is more: ask for the following page
+		query := url.Values{}
+		for key, values := range p.query {
+			query[key] = values
+		}
+		query.Set("page", strconv.Itoa(p.page))
+
+		apiurl, _ := url.Parse(p.next)
+		apiurl.RawQuery = encodeQuery(query)
+		p.next = apiurl.String()
+	default:
+		p.next = ""
+	}
+
+	return items, nil
+}
+
+// Next advances to the next object, fetching pages as needed.
+// It returns false when there are no more objects or a request failed; check Err.
+func (p *Paginator[T]) Next(ctx context.Context) bool {
+	for p.index+1 >= len(p.items) {
+		if !p.HasMore() {
+			return false
+		}
+
+		items, err := p.NextPage(ctx)
+		if err != nil {
+			return false
+		}
+		p.items, p.index = items, -1
+	}
+
+	p.index++
+	return true
+}
+
+// Item returns the current object. It is only valid after Next returned true.
+func (p *Paginator[T]) Item() T {
+	return p.items[p.index]
+}
+
+// Err returns the error that stopped the Paginator, if any.
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// Total returns the total number of objects the server reported on the last page fetched.
+func (p *Paginator[T]) Total() int {
+	return p.total
+}
+
+// ListAll fetches every remaining page and returns all of their objects.
+func (p *Paginator[T]) ListAll(ctx context.Context) ([]T, error) {
+	all := []T{}
+	for p.HasMore() {
+		items, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+	}
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return all, nil
+}
+
+// fetch requests one page.
+func (p *Paginator[T]) fetch(ctx context.Context, pageURL string) (ResultPage[T], error) {
+	resp, err := p.client.makeRequest(ctx, "GET", pageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	page := p.newPage()
+	err = json.NewDecoder(resp.Body).Decode(page)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// resolve turns an href from a page, usually just a path and query, into a full URL on the CloudBolt server.
+func (p *Paginator[T]) resolve(href string) (string, error) {
+	ref, err := url.Parse(href)
+	if err != nil {
+		return "", err
+	}
+
+	apiurl := p.client.baseURL
+	apiurl.Path = ref.Path
+	apiurl.RawQuery = ref.RawQuery
+
+	return apiurl.String(), nil
+}
+
+// PageInfo returns the links and counts of a page of results.
+func (r *CloudBoltResult) PageInfo() *CloudBoltResult {
+	return r
+}
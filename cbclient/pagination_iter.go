@@ -0,0 +1,32 @@
+//go:build go1.23
+
+package cbclient
+
+import (
+	"context"
+	"iter"
+)
+
+// All returns an iterator over every remaining object, fetching pages as needed.
+// If a request fails, the iterator yields the error once and stops:
+//
+//	for server, err := range p.All(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (p *Paginator[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.Next(ctx) {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+
+		if err := p.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+//go:build go1.23
+
+package cbclient
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPaginatorAll(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForPaginatedBlueprints)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	p := NewPaginator[CloudBoltReferenceFields, CloudBoltBlueprintResult](client, "/api/v3/cmp/blueprints/", nil)
+
+	ids := []string{}
+	for blueprint, err := range p.All(context.Background()) {
+		Expect(err).NotTo(HaveOccurred())
+		ids = append(ids, blueprint.ID)
+		if len(ids) == 3 {
+			break
+		}
+	}
+	Expect(ids).To(Equal([]string{"BP-00000001", "BP-00000002", "BP-00000003"}))
+
+	// Breaking out early doesn't fetch the last page
+	Expect(len(*requests)).To(Equal(4))
+}
+
+func TestPaginatorAllError(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForPaginatedBlueprintsError)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	p := NewPaginator[CloudBoltReferenceFields, CloudBoltBlueprintResult](client, "/api/v3/cmp/blueprints/", nil)
+
+	count := 0
+	var lastErr error
+	for _, err := range p.All(context.Background()) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		count++
+	}
+	Expect(count).To(Equal(2))
+	Expect(lastErr).To(HaveOccurred())
+	Expect(lastErr).To(Equal(p.Err()))
+}
@@ -0,0 +1,169 @@
+package cbclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPaginatorNextPage(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForPaginatedBlueprints)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	ctx := context.Background()
+	p := NewPaginator[CloudBoltReferenceFields, CloudBoltBlueprintResult](client, "/api/v3/cmp/blueprints/", &ListOptions{PageSize: 2})
+	Expect(p.HasMore()).To(BeTrue())
+
+	page, err := p.NextPage(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(page).To(HaveLen(2))
+	Expect(page[0].ID).To(Equal("BP-00000001"))
+	Expect(p.Total()).To(Equal(5))
+	Expect(p.HasMore()).To(BeTrue())
+
+	page, err = p.NextPage(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(page).To(HaveLen(2))
+	Expect(page[0].ID).To(Equal("BP-00000003"))
+
+	page, err = p.NextPage(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(page).To(HaveLen(1))
+	Expect(page[0].ID).To(Equal("BP-00000005"))
+	Expect(p.HasMore()).To(BeFalse())
+
+	// Once there are no pages left there is nothing more to fetch
+	page, err = p.NextPage(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(page).To(BeEmpty())
+
+	// 1+2. Fail to get the first page, get a token
+	// 3. Successfully getting the first page
+	// 4+5. Following the next links
+	Expect(len(*requests)).To(Equal(5))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/blueprints/"))
+	Expect((*requests)[2].URL.Query().Get("page_size")).To(Equal("2"))
+	Expect((*requests)[3].URL.RequestURI()).To(Equal("/api/v3/cmp/blueprints/?page=2&page_size=2"))
+	Expect((*requests)[4].URL.RequestURI()).To(Equal("/api/v3/cmp/blueprints/?page=3&page_size=2"))
+}
+
+func TestPaginatorNext(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForPaginatedBlueprints)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	ctx := context.Background()
+	p := NewPaginator[CloudBoltReferenceFields, CloudBoltBlueprintResult](client, "/api/v3/cmp/blueprints/", nil)
+
+	ids := []string{}
+	for p.Next(ctx) {
+		ids = append(ids, p.Item().ID)
+	}
+	Expect(p.Err()).NotTo(HaveOccurred())
+	Expect(ids).To(Equal([]string{"BP-00000001", "BP-00000002", "BP-00000003", "BP-00000004", "BP-00000005"}))
+	Expect(len(*requests)).To(Equal(5))
+
+	// No page_size unless one is asked for
+	Expect((*requests)[2].URL.RawQuery).To(BeEmpty())
+}
+
+func TestPaginatorListAll(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForPaginatedBlueprints)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	p := NewPaginator[CloudBoltReferenceFields, CloudBoltBlueprintResult](client, "/api/v3/cmp/blueprints/", &ListOptions{PageSize: 2})
+	blueprints, err := p.ListAll(context.Background())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprints).To(HaveLen(5))
+	Expect(blueprints[4].Name).To(Equal("Blueprint 5"))
+	Expect(p.Total()).To(Equal(5))
+	Expect(len(*requests)).To(Equal(5))
+}
+
+func TestPaginatorWithoutNextLinks(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForPaginatedServers)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	opts := &ListOptions{
+		Filter: NewFilter().Eq("status", "ACTIVE"),
+		Query:  map[string][]string{"extra": {"yes"}},
+	}
+	p := NewPaginator[CloudBoltServer, CloudBoltServerResult](client, "/api/v3/cmp/servers/", opts)
+	servers, err := p.ListAll(context.Background())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(servers).To(HaveLen(3))
+	Expect(servers[2].Hostname).To(Equal("server-3"))
+
+	// The second page is asked for by number, keeping the original query
+	Expect(len(*requests)).To(Equal(4))
+	Expect((*requests)[2].URL.Query().Get("filter")).To(Equal("status:ACTIVE"))
+	Expect((*requests)[3].URL.Query().Get("filter")).To(Equal("status:ACTIVE"))
+	Expect((*requests)[3].URL.Query().Get("extra")).To(Equal("yes"))
+	Expect((*requests)[3].URL.Query().Get("page")).To(Equal("2"))
+}
+
+func TestPaginatorError(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForPaginatedBlueprintsError)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	ctx := context.Background()
+	p := NewPaginator[CloudBoltReferenceFields, CloudBoltBlueprintResult](client, "/api/v3/cmp/blueprints/", nil)
+
+	count := 0
+	for p.Next(ctx) {
+		count++
+	}
+	Expect(count).To(Equal(2))
+
+	var apiErr *APIError
+	Expect(errors.As(p.Err(), &apiErr)).To(BeTrue())
+	Expect(apiErr.StatusCode).To(Equal(http.StatusInternalServerError))
+	Expect(p.HasMore()).To(BeFalse())
+
+	// The error sticks
+	_, err := p.NextPage(ctx)
+	Expect(err).To(Equal(p.Err()))
+	Expect(len(*requests)).To(Equal(4))
+}
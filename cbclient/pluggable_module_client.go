@@ -1,11 +1,10 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"net/url"
 	"strconv"
 )
 
@@ -16,16 +15,37 @@ type ModulePolicyResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the ModulePolicies on this page of results.
+func (r *ModulePolicyResult) Items() []ModulePolicy {
+	return r.Embedded.ModulePolicies
+}
+
+type ModuleDeploymentResult struct {
+	CloudBoltResult
+	Embedded struct {
+		ModuleDeployments []ModuleDeployment `json:"moduleManagedObjects"`
+	} `json:"_embedded"`
+}
+
+// Items returns the Module Deployments on this page of results.
+func (r *ModuleDeploymentResult) Items() []ModuleDeployment {
+	return r.Embedded.ModuleDeployments
+}
+
 type ModulePolicy struct {
 	Links *struct {
 		Self      CloudBoltHALItem `json:"self,omitempty"`
 		Workspace CloudBoltHALItem `json:"workspace,omitempty"`
 		Blueprint CloudBoltHALItem `json:"blueprint,omitempty"`
 	} `json:"_links,omitempty"`
-	ID             int    `json:"id,omitempty"`
-	Name           string `json:"name,omitempty"`
-	Description    string `json:"description,omitempty"`
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	WorkspaceURL string `json:"workspace,omitempty"`
+	// Blueprint is the URL of the module the policy runs, e.g., "/api/v3/onefuse/modules/BP-vjsxfc2z/"
+	Blueprint      string `json:"blueprint,omitempty"`
 	PolicyTemplate string `json:"policyTemplate,omitempty"`
+	ActionPayloads string `json:"actionPayloads,omitempty"`
 }
 
 type ModuleDeployment struct {
@@ -46,17 +66,31 @@ type ModuleDeployment struct {
 	DeprovisioningJobResults []map[string]interface{} `json:"deprovisioningJobResults,omitempty"`
 }
 
-func (c *CloudBoltClient) GetModulePolicy(name string) (*ModulePolicy, error) {
+func (c *CloudBoltClient) GetModulePolicy(name string, filters ...*Filter) (*ModulePolicy, error) {
+	return c.GetModulePolicyWithContext(context.Background(), name, filters...)
+}
+
+// GetModulePolicyWithContext is the same as GetModulePolicy with a caller-provided context.
+func (c *CloudBoltClient) GetModulePolicyWithContext(ctx context.Context, name string, filters ...*Filter) (*ModulePolicy, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "modulePolicies")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res ModulePolicyResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -73,10 +107,15 @@ func (c *CloudBoltClient) GetModulePolicy(name string) (*ModulePolicy, error) {
 }
 
 func (c *CloudBoltClient) CreateModuleDeployment(moduleDeployment *ModuleDeployment) (*OneFuseJobStatus, error) {
-	log.Println("onefuse.apiClient: CreateModuleDeployment")
+	return c.CreateModuleDeploymentWithContext(context.Background(), moduleDeployment)
+}
+
+// CreateModuleDeploymentWithContext is the same as CreateModuleDeployment with a caller-provided context.
+func (c *CloudBoltClient) CreateModuleDeploymentWithContext(ctx context.Context, moduleDeployment *ModuleDeployment) (*OneFuseJobStatus, error) {
+	c.log().Debug("onefuse.apiClient: CreateModuleDeployment")
 
 	if moduleDeployment.WorkspaceURL == "" {
-		workspace, err := c.GetDefaultWorkSpace()
+		workspace, err := c.GetDefaultWorkSpaceWithContext(ctx)
 
 		if err != nil {
 			return nil, err
@@ -85,7 +124,6 @@ func (c *CloudBoltClient) CreateModuleDeployment(moduleDeployment *ModuleDeploym
 		moduleDeployment.WorkspaceURL = workspace.Links.Self.Href
 	}
 
-	log.Println("onefuse.apiClient: CreateModuleDeployment")
 	if moduleDeployment.Policy == "" {
 		if moduleDeployment.PolicyID != 0 {
 			moduleDeployment.Policy = c.apiEndpoint("onefuse", "modulePolicies", strconv.Itoa(moduleDeployment.PolicyID))
@@ -104,10 +142,11 @@ func (c *CloudBoltClient) CreateModuleDeployment(moduleDeployment *ModuleDeploym
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "moduleManagedObjects")
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -119,13 +158,22 @@ func (c *CloudBoltClient) CreateModuleDeployment(moduleDeployment *ModuleDeploym
 }
 
 func (c *CloudBoltClient) GetModuleDeployment(moduleDeploymentPath string) (*ModuleDeployment, error) {
+	return c.GetModuleDeploymentWithContext(context.Background(), moduleDeploymentPath)
+}
+
+// GetModuleDeploymentWithContext is the same as GetModuleDeployment with a caller-provided context.
+func (c *CloudBoltClient) GetModuleDeploymentWithContext(ctx context.Context, moduleDeploymentPath string) (*ModuleDeployment, error) {
 	apiurl := c.baseURL
 	apiurl.Path = moduleDeploymentPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -137,13 +185,22 @@ func (c *CloudBoltClient) GetModuleDeployment(moduleDeploymentPath string) (*Mod
 }
 
 func (c *CloudBoltClient) GetModuleDeploymentById(moduleDeploymentId string) (*ModuleDeployment, error) {
+	return c.GetModuleDeploymentByIdWithContext(context.Background(), moduleDeploymentId)
+}
+
+// GetModuleDeploymentByIdWithContext is the same as GetModuleDeploymentById with a caller-provided context.
+func (c *CloudBoltClient) GetModuleDeploymentByIdWithContext(ctx context.Context, moduleDeploymentId string) (*ModuleDeployment, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "moduleManagedObjects", moduleDeploymentId)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -155,13 +212,19 @@ func (c *CloudBoltClient) GetModuleDeploymentById(moduleDeploymentId string) (*M
 }
 
 func (c *CloudBoltClient) DeleteModuleDeployment(moduleDeploymentId string) (*OneFuseJobStatus, error) {
+	return c.DeleteModuleDeploymentWithContext(context.Background(), moduleDeploymentId)
+}
+
+// DeleteModuleDeploymentWithContext is the same as DeleteModuleDeployment with a caller-provided context.
+func (c *CloudBoltClient) DeleteModuleDeploymentWithContext(ctx context.Context, moduleDeploymentId string) (*OneFuseJobStatus, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "moduleManagedObjects", moduleDeploymentId)
 
-	resp, err := c.makeRequest("DELETE", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "DELETE", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -171,3 +234,84 @@ func (c *CloudBoltClient) DeleteModuleDeployment(moduleDeploymentId string) (*On
 
 	return job_status, nil
 }
+
+// ListModulePolicies fetches every Module Policy the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListModulePolicies(opts *ListOptions) ([]ModulePolicy, error) {
+	return c.ListModulePoliciesWithContext(context.Background(), opts)
+}
+
+// ListModulePoliciesWithContext is the same as ListModulePolicies with a caller-provided context.
+func (c *CloudBoltClient) ListModulePoliciesWithContext(ctx context.Context, opts *ListOptions) ([]ModulePolicy, error) {
+	return NewPaginator[ModulePolicy, ModulePolicyResult](c, c.apiEndpoint("onefuse", "modulePolicies"), opts).ListAll(ctx)
+}
+
+// ListModuleDeployments fetches every Module Deployment the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., NewFilter().Policy(2).Archived(false).
+func (c *CloudBoltClient) ListModuleDeployments(opts *ListOptions) ([]ModuleDeployment, error) {
+	return c.ListModuleDeploymentsWithContext(context.Background(), opts)
+}
+
+// ListModuleDeploymentsWithContext is the same as ListModuleDeployments with a caller-provided context.
+func (c *CloudBoltClient) ListModuleDeploymentsWithContext(ctx context.Context, opts *ListOptions) ([]ModuleDeployment, error) {
+	return NewPaginator[ModuleDeployment, ModuleDeploymentResult](c, c.apiEndpoint("onefuse", "moduleManagedObjects"), opts).ListAll(ctx)
+}
+
+// CreateModulePolicy creates a Module Policy in OneFuse and returns it as saved.
+// If newPolicy has no WorkspaceURL it goes in the default workspace.
+func (c *CloudBoltClient) CreateModulePolicy(newPolicy *ModulePolicy) (*ModulePolicy, error) {
+	return c.CreateModulePolicyWithContext(context.Background(), newPolicy)
+}
+
+// CreateModulePolicyWithContext is the same as CreateModulePolicy with a caller-provided context.
+func (c *CloudBoltClient) CreateModulePolicyWithContext(ctx context.Context, newPolicy *ModulePolicy) (*ModulePolicy, error) {
+	var policy ModulePolicy
+	err := c.createPolicy(ctx, "modulePolicies", &newPolicy.WorkspaceURL, newPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// GetModulePolicyByID fetches the Module Policy with the given ID.
+func (c *CloudBoltClient) GetModulePolicyByID(policyId string) (*ModulePolicy, error) {
+	return c.GetModulePolicyByIDWithContext(context.Background(), policyId)
+}
+
+// GetModulePolicyByIDWithContext is the same as GetModulePolicyByID with a caller-provided context.
+func (c *CloudBoltClient) GetModulePolicyByIDWithContext(ctx context.Context, policyId string) (*ModulePolicy, error) {
+	var policy ModulePolicy
+	err := c.getPolicy(ctx, "modulePolicies", policyId, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// UpdateModulePolicy replaces the Module Policy with the given ID and returns it as saved.
+func (c *CloudBoltClient) UpdateModulePolicy(policyId string, updatedPolicy *ModulePolicy) (*ModulePolicy, error) {
+	return c.UpdateModulePolicyWithContext(context.Background(), policyId, updatedPolicy)
+}
+
+// UpdateModulePolicyWithContext is the same as UpdateModulePolicy with a caller-provided context.
+func (c *CloudBoltClient) UpdateModulePolicyWithContext(ctx context.Context, policyId string, updatedPolicy *ModulePolicy) (*ModulePolicy, error) {
+	var policy ModulePolicy
+	err := c.updatePolicy(ctx, "modulePolicies", policyId, updatedPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// DeleteModulePolicy deletes the Module Policy with the given ID.
+func (c *CloudBoltClient) DeleteModulePolicy(policyId string) error {
+	return c.DeleteModulePolicyWithContext(context.Background(), policyId)
+}
+
+// DeleteModulePolicyWithContext is the same as DeleteModulePolicy with a caller-provided context.
+func (c *CloudBoltClient) DeleteModulePolicyWithContext(ctx context.Context, policyId string) error {
+	return c.deletePolicy(ctx, "modulePolicies", policyId)
+}
@@ -193,3 +193,201 @@ func TestGetModuleDeploymentId(t *testing.T) {
 	Expect(len(moduleDeployment.DeprovisioningJobResults)).To(Equal(0))
 	Expect(moduleDeployment.Archived).To(Equal(false))
 }
+
+func TestListModulePolicies(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListModulePolicies)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	policies, err := client.ListModulePolicies(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Module Policies, get a token
+	// 3. Successfully getting the only page of Module Policies
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/modulePolicies/"))
+
+	Expect(policies).To(HaveLen(1))
+	Expect(policies[0].ID).To(Equal(180))
+}
+
+func TestListModuleDeployments(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListModuleDeployments)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	deployments, err := client.ListModuleDeployments(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Module Deployments, get a token
+	// 3. Successfully getting the only page of Module Deployments
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/moduleManagedObjects/"))
+
+	Expect(deployments).To(HaveLen(1))
+	Expect(deployments[0].ID).To(Equal(75))
+}
+
+func TestCreateModulePolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleModulePolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	newPolicy := ModulePolicy{
+		Name:           "My_Module_Policy",
+		Description:    "A Module Policy created through automated tests",
+		WorkspaceURL:   "/api/v3/onefuse/workspaces/2/",
+		Blueprint:      "/api/v3/onefuse/modules/BP-vjsxfc2z/",
+		PolicyTemplate: `{"provisioningPayload": {}}`,
+	}
+
+	policy, err := client.CreateModulePolicy(&newPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to create, get a token
+	// 3. Successful create
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/modulePolicies/"))
+
+	verifyModulePolicy(policy)
+}
+
+func TestGetModulePolicyByID(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleModulePolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	policy, err := client.GetModulePolicyByID("180")
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get, get a token
+	// 3. Successful Get
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/modulePolicies/180/"))
+
+	verifyModulePolicy(policy)
+}
+
+func TestUpdateModulePolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleModulePolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	updatedPolicy := ModulePolicy{
+		Name:           "My_Module_Policy",
+		Description:    "A Module Policy created through automated tests",
+		WorkspaceURL:   "/api/v3/onefuse/workspaces/2/",
+		Blueprint:      "/api/v3/onefuse/modules/BP-vjsxfc2z/",
+		PolicyTemplate: `{"provisioningPayload": {}}`,
+	}
+
+	policy, err := client.UpdateModulePolicy("180", &updatedPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to update, get a token
+	// 3. Successful update
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("PUT"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/modulePolicies/180/"))
+
+	verifyModulePolicy(policy)
+}
+
+func TestDeleteModulePolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleModulePolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	err := client.DeleteModulePolicy("180")
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to delete, get a token
+	// 3. Successful delete
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("DELETE"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/modulePolicies/180/"))
+}
+
+func verifyModulePolicy(policy *ModulePolicy) {
+	Expect(policy.Links.Self.Href).To(Equal("/api/v3/onefuse/modulePolicies/180/"))
+	Expect(policy.Links.Self.Title).To(Equal("My_Module_Policy"))
+	Expect(policy.Links.Workspace.Href).To(Equal("/api/v3/onefuse/workspaces/2/"))
+	Expect(policy.ID).To(Equal(180))
+	Expect(policy.Name).To(Equal("My_Module_Policy"))
+	Expect(policy.Links.Blueprint.Href).To(Equal("/api/v3/onefuse/modules/BP-vjsxfc2z/"))
+	Expect(policy.ActionPayloads).To(Equal(""))
+}
@@ -0,0 +1,75 @@
+package cbclient
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// The OneFuse policy endpoints all work the same way:
+// POST to /api/v3/onefuse/<collection>/ to create a policy,
+// and GET, PUT or DELETE /api/v3/onefuse/<collection>/<id>/ to read, replace or remove one.
+// The Create*Policy, Get*PolicyByID, Update*Policy and Delete*Policy methods are built on these helpers.
+
+// createPolicy sends policy to the collection and decodes the created policy into out.
+// An empty workspaceURL is set to the default workspace first.
+func (c *CloudBoltClient) createPolicy(ctx context.Context, collection string, workspaceURL *string, policy interface{}, out interface{}) error {
+	if *workspaceURL == "" {
+		workspace, err := c.GetDefaultWorkSpaceWithContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		*workspaceURL = workspace.Links.Self.Href
+	}
+
+	return c.sendPolicy(ctx, "POST", c.apiEndpoint("onefuse", collection), policy, out)
+}
+
+// getPolicy decodes the policy with the given ID into out.
+func (c *CloudBoltClient) getPolicy(ctx context.Context, collection string, policyId string, out interface{}) error {
+	return c.sendPolicy(ctx, "GET", c.apiEndpoint("onefuse", collection, policyId), nil, out)
+}
+
+// updatePolicy replaces the policy with the given ID and decodes the result into out.
+func (c *CloudBoltClient) updatePolicy(ctx context.Context, collection string, policyId string, policy interface{}, out interface{}) error {
+	return c.sendPolicy(ctx, "PUT", c.apiEndpoint("onefuse", collection, policyId), policy, out)
+}
+
+// deletePolicy removes the policy with the given ID.
+func (c *CloudBoltClient) deletePolicy(ctx context.Context, collection string, policyId string) error {
+	return c.sendPolicy(ctx, "DELETE", c.apiEndpoint("onefuse", collection, policyId), nil, nil)
+}
+
+// sendPolicy makes the request, with policy as the JSON body if it isn't nil,
+// and decodes the response into out if it isn't nil.
+func (c *CloudBoltClient) sendPolicy(ctx context.Context, method string, path string, policy interface{}, out interface{}) error {
+	var reqJSON []byte
+	if policy != nil {
+		var err error
+		reqJSON, err = json.Marshal(policy)
+		if err != nil {
+			return err
+		}
+	}
+
+	apiurl := c.baseURL
+	apiurl.Path = path
+
+	resp, err := c.makeRequest(ctx, method, apiurl.String(), reqJSON)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Handle some common HTTP errors
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -1,9 +1,8 @@
 package cbclient
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 )
 
 type RenderTemplateResponse struct {
@@ -16,6 +15,11 @@ type RenderTemplateRequest struct {
 }
 
 func (c *CloudBoltClient) RenderTemplate(template string, templateProperties map[string]interface{}) (*RenderTemplateResponse, error) {
+	return c.RenderTemplateWithContext(context.Background(), template, templateProperties)
+}
+
+// RenderTemplateWithContext is the same as RenderTemplate with a caller-provided context.
+func (c *CloudBoltClient) RenderTemplateWithContext(ctx context.Context, template string, templateProperties map[string]interface{}) (*RenderTemplateResponse, error) {
 	requestBody := RenderTemplateRequest{
 		Template:           template,
 		TemplateProperties: templateProperties,
@@ -29,24 +33,21 @@ func (c *CloudBoltClient) RenderTemplate(template string, templateProperties map
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "templateTester")
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
 	// Handle some common HTTP errors
-	switch {
-	case resp.StatusCode >= 500:
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
-		respBody := buf.String()
-		return nil, fmt.Errorf("received a server error: %s", respBody)
-	case resp.StatusCode >= 400:
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
-		respBody := buf.String()
-		return nil, fmt.Errorf("received an HTTP client error: %s", respBody)
-	default:
-		// We Decode the data because we already have an io.Reader on hand
-		var renderTemplateResponse RenderTemplateResponse
-		json.NewDecoder(resp.Body).Decode(&renderTemplateResponse)
-
-		return &renderTemplateResponse, nil
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
 	}
+
+	// We Decode the data because we already have an io.Reader on hand
+	var renderTemplateResponse RenderTemplateResponse
+	json.NewDecoder(resp.Body).Decode(&renderTemplateResponse)
+
+	return &renderTemplateResponse, nil
 }
@@ -1,11 +1,9 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
-	"net/http"
-	"net/url"
 )
 
 // CloudBoltResource contains metadata about Resources (e.g., "Services") in CloudBolt
@@ -23,20 +21,20 @@ type CloudBoltResource struct {
 	} `json:"_links"`
 	Name       string                   `json:"name"`
 	ID         string                   `json:"id"`
-	Created    string                   `json:"created"`
+	Created    CloudBoltTime            `json:"created"`
 	Status     string                   `json:"status"`
 	Attributes []map[string]interface{} `json:"attributes"`
 }
 
 type CloudBoltResourceJobInfo []struct {
-	Title     string `json:"title"`
-	StartDate string `json:"startDate"`
-	EndDate   string `json:"endDate"`
-	Status    string `json:"status"`
-	Output    string `json:"output"`
-	Error     string `json:"error"`
-	Outputs   []map[string]interface{} `json:"outputs"`
-	ProgressMessages []string `json:"progressMessages"`
+	Title            string                   `json:"title"`
+	StartDate        CloudBoltTime            `json:"startDate"`
+	EndDate          CloudBoltTime            `json:"endDate"`
+	Status           string                   `json:"status"`
+	Output           string                   `json:"output"`
+	Error            string                   `json:"error"`
+	Outputs          []map[string]interface{} `json:"outputs"`
+	ProgressMessages []string                 `json:"progressMessages"`
 }
 
 type CloudBoltResourceResult struct {
@@ -46,19 +44,29 @@ type CloudBoltResourceResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the Resources on this page of results.
+func (r *CloudBoltResourceResult) Items() []CloudBoltResource {
+	return r.Embedded.Resources
+}
 
 func (c *CloudBoltClient) GetResourceById(id string) (*CloudBoltResource, error) {
+	return c.GetResourceByIdWithContext(context.Background(), id)
+}
+
+// GetResourceByIdWithContext is the same as GetResourceById with a caller-provided context.
+func (c *CloudBoltClient) GetResourceByIdWithContext(ctx context.Context, id string) (*CloudBoltResource, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "resources", id)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrNotFound
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
 	}
 
 	var res CloudBoltResource
@@ -67,12 +75,28 @@ func (c *CloudBoltClient) GetResourceById(id string) (*CloudBoltResource, error)
 	return &res, nil
 }
 
-func (c *CloudBoltClient) GetResourceByName(name string) (*CloudBoltResource, error) {
+func (c *CloudBoltClient) GetResourceByName(name string, filters ...*Filter) (*CloudBoltResource, error) {
+	return c.GetResourceByNameWithContext(context.Background(), name, filters...)
+}
+
+// GetResourceByNameWithContext is the same as GetResourceByName with a caller-provided context.
+func (c *CloudBoltClient) GetResourceByNameWithContext(ctx context.Context, name string, filters ...*Filter) (*CloudBoltResource, error) {
+	query, err := NewFilter().Eq("name", name).Eq("status", "ACTIVE").And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "resources")
-	apiurl.RawQuery = fmt.Sprintf(filterByName+";status:ACTIVE", url.QueryEscape(name))
+	apiurl.RawQuery = query
+
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	err = checkHttpStatus(resp)
 	if err != nil {
 		return nil, err
 	}
@@ -102,21 +126,25 @@ func (c *CloudBoltClient) GetResourceByName(name string) (*CloudBoltResource, er
 // GetResource fetches a Resource object from CloudBolt at the given path
 // - Resource Path (resourcePath) e.g., "/api/v2/resources/service/123/"
 func (c *CloudBoltClient) GetResource(resourcePath string) (*CloudBoltResource, error) {
+	return c.GetResourceWithContext(context.Background(), resourcePath)
+}
+
+// GetResourceWithContext is the same as GetResource with a caller-provided context.
+func (c *CloudBoltClient) GetResourceWithContext(ctx context.Context, resourcePath string) (*CloudBoltResource, error) {
 	apiurl := c.baseURL
 	apiurl.Path = resourcePath
 
 	// log.Printf("[!!] apiurl in GetResource: %+v (%+v)", apiurl.String(), apiurl)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
 		return nil, err
 	}
-
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrNotFound
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
 	}
 
 	// We Decode the data because we already have an io.Reader on hand
@@ -127,17 +155,23 @@ func (c *CloudBoltClient) GetResource(resourcePath string) (*CloudBoltResource,
 }
 
 func (c *CloudBoltClient) GetResourceJobInfoById(id string) (*CloudBoltResourceJobInfo, error) {
+	return c.GetResourceJobInfoByIdWithContext(context.Background(), id)
+}
+
+// GetResourceJobInfoByIdWithContext is the same as GetResourceJobInfoById with a caller-provided context.
+func (c *CloudBoltClient) GetResourceJobInfoByIdWithContext(ctx context.Context, id string) (*CloudBoltResourceJobInfo, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "resources", id, "jobsInfo")
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrNotFound
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
 	}
 
 	var res CloudBoltResourceJobInfo
@@ -149,17 +183,23 @@ func (c *CloudBoltClient) GetResourceJobInfoById(id string) (*CloudBoltResourceJ
 // GetResourceJobInfo fetches job info for a Resource at the given path
 // - Job Info Path (jobInfoPath) e.g., "/api/v3/cmp/resources/123/jobsInfo/"
 func (c *CloudBoltClient) GetResourceJobInfo(jobInfoPath string) (*CloudBoltResourceJobInfo, error) {
+	return c.GetResourceJobInfoWithContext(context.Background(), jobInfoPath)
+}
+
+// GetResourceJobInfoWithContext is the same as GetResourceJobInfo with a caller-provided context.
+func (c *CloudBoltClient) GetResourceJobInfoWithContext(ctx context.Context, jobInfoPath string) (*CloudBoltResourceJobInfo, error) {
 	apiurl := c.baseURL
 	apiurl.Path = jobInfoPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrNotFound
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
 	}
 
 	var res CloudBoltResourceJobInfo
@@ -167,3 +207,18 @@ func (c *CloudBoltClient) GetResourceJobInfo(jobInfoPath string) (*CloudBoltReso
 
 	return &res, nil
 }
+
+// ListResources fetches every Resource the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by name, resource type or status:
+//
+//	resources, err := client.ListResources(&cbclient.ListOptions{
+//		Filter: cbclient.NewFilter().Status("ACTIVE").Eq("resource_type__name", "service"),
+//	})
+func (c *CloudBoltClient) ListResources(opts *ListOptions) ([]CloudBoltResource, error) {
+	return c.ListResourcesWithContext(context.Background(), opts)
+}
+
+// ListResourcesWithContext is the same as ListResources with a caller-provided context.
+func (c *CloudBoltClient) ListResourcesWithContext(ctx context.Context, opts *ListOptions) ([]CloudBoltResource, error) {
+	return NewPaginator[CloudBoltResource, CloudBoltResourceResult](c, c.apiEndpoint("cmp", "resources"), opts).ListAll(ctx)
+}
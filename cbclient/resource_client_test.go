@@ -2,6 +2,7 @@ package cbclient
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -69,7 +70,7 @@ func TestGetResource(t *testing.T) {
 	Expect(resource.Links.Servers[0].Title).To(Equal("myawsinstance"))
 	Expect(resource.Name).To(Equal("My Simple Blueprint"))
 	Expect(resource.ID).To(Equal("RSC-hjt2wha2"))
-	Expect(resource.Created).To(Equal("2022-04-10 10:04:15"))
+	Expect(resource.Created.Time).To(Equal(time.Date(2022, 4, 10, 10, 4, 15, 0, time.UTC)))
 	Expect(resource.Status).To(Equal("ACTIVE"))
 	Expect(resource.Attributes).To(Not(BeNil()))
 }
@@ -137,7 +138,7 @@ func TestGetResourceByID(t *testing.T) {
 	Expect(resource.Links.Servers[0].Title).To(Equal("myawsinstance"))
 	Expect(resource.Name).To(Equal("My Simple Blueprint"))
 	Expect(resource.ID).To(Equal("RSC-hjt2wha2"))
-	Expect(resource.Created).To(Equal("2022-04-10 10:04:15"))
+	Expect(resource.Created.Time).To(Equal(time.Date(2022, 4, 10, 10, 4, 15, 0, time.UTC)))
 	Expect(resource.Status).To(Equal("ACTIVE"))
 	Expect(resource.Attributes).To(Not(BeNil()))
 }
@@ -205,7 +206,35 @@ func TestGetResourceByName(t *testing.T) {
 	Expect(resource.Links.Servers[0].Title).To(Equal("myawsinstance"))
 	Expect(resource.Name).To(Equal("My Simple Blueprint"))
 	Expect(resource.ID).To(Equal("RSC-no9aztne"))
-	Expect(resource.Created).To(Equal("2022-04-10 10:04:15"))
+	Expect(resource.Created.Time).To(Equal(time.Date(2022, 4, 10, 10, 4, 15, 0, time.UTC)))
 	Expect(resource.Status).To(Equal("ACTIVE"))
 	Expect(resource.Attributes).To(Not(BeNil()))
 }
+
+func TestListResources(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListResources)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	resources, err := client.ListResources(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Resources, get a token
+	// 3. Successfully getting the only page of Resources
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/resources/"))
+
+	Expect(resources).To(HaveLen(1))
+	Expect(resources[0].ID).To(Equal("RSC-no9aztne"))
+}
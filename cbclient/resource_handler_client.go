@@ -1,9 +1,9 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 type CloudBoltResourceHandlerResult struct {
@@ -13,19 +13,37 @@ type CloudBoltResourceHandlerResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the ResourceHandlers on this page of results.
+func (r *CloudBoltResourceHandlerResult) Items() []CloudBoltReferenceFields {
+	return r.Embedded.ResourceHandlers
+}
+
 // GetResourceHandler accepts the name of a Resource Handler
-//
-func (c *CloudBoltClient) GetResourceHandler(name string) (*CloudBoltReferenceFields, error) {
+func (c *CloudBoltClient) GetResourceHandler(name string, filters ...*Filter) (*CloudBoltReferenceFields, error) {
+	return c.GetResourceHandlerWithContext(context.Background(), name, filters...)
+}
+
+// GetResourceHandlerWithContext is the same as GetResourceHandler with a caller-provided context.
+func (c *CloudBoltClient) GetResourceHandlerWithContext(ctx context.Context, name string, filters ...*Filter) (*CloudBoltReferenceFields, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "resourceHandlers")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	// TODO: HTTP Response handling
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 	// We Decode the data because we already have an io.Reader on hand
 	var res CloudBoltResourceHandlerResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -41,6 +59,11 @@ func (c *CloudBoltClient) GetResourceHandler(name string) (*CloudBoltReferenceFi
 }
 
 func (c *CloudBoltClient) GetResourceHandlerById(id string) (*CloudBoltReferenceFields, error) {
+	return c.GetResourceHandlerByIdWithContext(context.Background(), id)
+}
+
+// GetResourceHandlerByIdWithContext is the same as GetResourceHandlerById with a caller-provided context.
+func (c *CloudBoltClient) GetResourceHandlerByIdWithContext(ctx context.Context, id string) (*CloudBoltReferenceFields, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint(
 		"cmp",
@@ -48,16 +71,15 @@ func (c *CloudBoltClient) GetResourceHandlerById(id string) (*CloudBoltReference
 		id,
 	)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	// TODO: HTTP Response handling
-	if resp.StatusCode == 404 {
-		return nil, fmt.Errorf(
-			"Could not find resource handler with ID %s. Does the user have permission to view this?",
-			id,
-		)
+	defer resp.Body.Close()
+
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
 	}
 
 	// We Decode the data because we already have an io.Reader on hand
@@ -66,3 +88,18 @@ func (c *CloudBoltClient) GetResourceHandlerById(id string) (*CloudBoltReference
 
 	return &res, nil
 }
+
+// ListResourceHandlers fetches every Resource Handler the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by name or type of Resource Handler:
+//
+//	handlers, err := client.ListResourceHandlers(&cbclient.ListOptions{
+//		Filter: cbclient.NewFilter().Eq("resource_technology__name", "VMware vCenter"),
+//	})
+func (c *CloudBoltClient) ListResourceHandlers(opts *ListOptions) ([]CloudBoltReferenceFields, error) {
+	return c.ListResourceHandlersWithContext(context.Background(), opts)
+}
+
+// ListResourceHandlersWithContext is the same as ListResourceHandlers with a caller-provided context.
+func (c *CloudBoltClient) ListResourceHandlersWithContext(ctx context.Context, opts *ListOptions) ([]CloudBoltReferenceFields, error) {
+	return NewPaginator[CloudBoltReferenceFields, CloudBoltResourceHandlerResult](c, c.apiEndpoint("cmp", "resourceHandlers"), opts).ListAll(ctx)
+}
@@ -73,3 +73,31 @@ func TestGetResourceHandlerById(t *testing.T) {
 	Expect(rh.Name).To(Equal("My Test Resource Handler"))
 	Expect(rh.ID).To(Equal("RH-nza16uyn"))
 }
+
+func TestListResourceHandlers(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListResourceHandlers)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	resourceHandlers, err := client.ListResourceHandlers(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Resource Handlers, get a token
+	// 3. Successfully getting the only page of Resource Handlers
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/resourceHandlers/"))
+
+	Expect(resourceHandlers).To(HaveLen(1))
+	Expect(resourceHandlers[0].ID).To(Equal("RH-nza16uyn"))
+}
@@ -0,0 +1,238 @@
+package cbclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how the CloudBoltClient retries transient failures:
+// network errors such as timeouts and reset connections, and the status codes in RetryableStatusCodes.
+//
+// Retries are off unless a policy is set with SetRetryPolicy.
+// Requests that aren't idempotent (POST, PATCH) are only retried when RetryNonIdempotent is set,
+// since a retried DeployBlueprint or Create*Reservation may submit the same thing twice.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the computed delay. A Retry-After header from the server is honored as-is.
+	MaxBackoff time.Duration
+	// Multiplier is applied to the delay after every retry.
+	Multiplier float64
+	// Jitter is the fraction (0-1) of each delay that is randomized.
+	Jitter float64
+	// RetryNonIdempotent allows POST and PATCH requests to be retried.
+	RetryNonIdempotent bool
+	// RetryableStatusCodes are the HTTP status codes that are retried.
+	RetryableStatusCodes []int
+}
+
+// RetryError is returned when a request still failed after more than one attempt.
+// It wraps the last error, so errors.As still finds an *APIError for HTTP failures.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+// DefaultRetryPolicy returns a RetryPolicy that makes up to 4 attempts,
+// backing off from 500ms up to 30s, for connection errors, 429, 502, 503 and 504.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("giving up after %d attempts: %s", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// SetRetryPolicy sets the RetryPolicy used by the CloudBoltClient.
+// Passing nil turns retries off, which is the default.
+func (c *CloudBoltClient) SetRetryPolicy(policy *RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+// doRequest sends req, retrying transient failures according to the client's RetryPolicy.
+// idempotent says whether req may be sent more than once without the caller opting in.
+//
+// When more than one attempt was made and the last one still failed,
+// the returned error is a *RetryError. A retryable status on the last attempt
+// becomes an *APIError wrapped in that *RetryError.
+func (c *CloudBoltClient) doRequest(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := c.retryPolicy
+	if policy == nil || policy.MaxAttempts <= 1 || !(idempotent || policy.RetryNonIdempotent) {
+		return c.sendRequest(req)
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		// The body of the previous attempt was consumed, so send a fresh copy
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := c.sendRequest(attemptReq)
+
+		var lastErr error
+		var retryAfter time.Duration
+		switch {
+		case err != nil:
+			if !isRetryableError(ctx, err) {
+				return nil, wrapAttempts(attempt, err)
+			}
+			lastErr = err
+		case policy.isRetryableStatus(resp.StatusCode):
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+			lastErr = newAPIError(resp)
+			resp.Body.Close()
+		default:
+			return resp, nil
+		}
+
+		if attempt >= policy.MaxAttempts {
+			return nil, wrapAttempts(attempt, lastErr)
+		}
+
+		delay := retryAfter
+		if delay <= 0 {
+			delay = policy.backoff(attempt)
+		}
+
+		c.log().Warn("cbclient: retrying request", "method", req.Method, "url", req.URL.String(), "attempt", attempt, "delay", delay, "error", lastErr)
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, wrapAttempts(attempt, err)
+		}
+	}
+}
+
+// isIdempotent reports whether an HTTP method can safely be repeated.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryableError reports whether err is a transient network failure worth retrying:
+// a timeout, a refused or reset connection, or a connection closed before the response was complete.
+// Errors that would fail the same way again, e.g., TLS verification failures, unsupported URL schemes
+// and redirect policy errors, aren't retried, nor are errors caused by the caller's context.
+func isRetryableError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// isRetryableStatus reports whether the policy retries the given HTTP status code.
+func (p *RetryPolicy) isRetryableStatus(status int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay before the retry following the given attempt:
+// InitialBackoff * Multiplier^(attempt-1), capped at MaxBackoff, less up to Jitter of it at random.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		delay -= delay * math.Min(p.Jitter, 1) * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an HTTP date.
+// Returns 0 if the header is missing or can't be parsed.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
+
+// sleepContext waits for d, or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// wrapAttempts wraps err in a *RetryError if more than one attempt was made.
+func wrapAttempts(attempts int, err error) error {
+	if attempts <= 1 {
+		return err
+	}
+
+	return &RetryError{Attempts: attempts, Err: err}
+}
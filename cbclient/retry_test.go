@@ -0,0 +1,267 @@
+package cbclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// fastRetryPolicy retries the default status codes without waiting around.
+func fastRetryPolicy(maxAttempts int) *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = maxAttempts
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	policy.Jitter = 0
+
+	return policy
+}
+
+func TestRetryTransientStatus(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForRetryTransientStatus)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	blueprint, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprint).NotTo(BeNil())
+	Expect(blueprint.ID).To(Equal("BP-esnjtp7u"))
+
+	// 1+2. Fail to get the blueprint, get a token
+	// 3+4. 503 and 502 are retried
+	// 5. Successfully getting the blueprint
+	Expect(len(*requests)).To(Equal(5))
+	for _, r := range (*requests)[2:] {
+		Expect(r.URL.Path).To(Equal("/api/v3/cmp/blueprints/BP-esnjtp7u/"))
+		Expect(r.Header.Get("Authorization")).To(Equal("Bearer Testing Token"))
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForRetryExhausted)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	blueprint, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).To(HaveOccurred())
+	Expect(blueprint).To(BeNil())
+	Expect(len(*requests)).To(Equal(5))
+
+	// The attempt count and the last response are both available
+	var retryErr *RetryError
+	Expect(errors.As(err, &retryErr)).To(BeTrue())
+	Expect(retryErr.Attempts).To(Equal(3))
+	Expect(err.Error()).To(HavePrefix("giving up after 3 attempts: "))
+
+	var apiErr *APIError
+	Expect(errors.As(err, &apiErr)).To(BeTrue())
+	Expect(apiErr.StatusCode).To(Equal(http.StatusServiceUnavailable))
+	Expect(apiErr.Message).To(Equal("Service temporarily unavailable, try again later."))
+}
+
+func TestRetryAfter(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServerWithHeaders(responsesForRetryAfter, headersForRetryAfter)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	start := time.Now()
+	blueprint, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprint).NotTo(BeNil())
+	Expect(len(*requests)).To(Equal(4))
+
+	// The server's Retry-After wins over the much shorter backoff
+	Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+}
+
+func TestRetryConnectionErrors(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockBrokenServer()
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	_, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).To(HaveOccurred())
+	Expect(len(*requests)).To(Equal(3))
+
+	var retryErr *RetryError
+	Expect(errors.As(err, &retryErr)).To(BeTrue())
+	Expect(retryErr.Attempts).To(Equal(3))
+}
+
+func TestRetryPermanentErrors(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Count the connections made to a TLS server whose certificate the client doesn't trust
+	var connections int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	client, err := NewClient(server.URL, WithCredentials("testUser", "testPass"))
+	Expect(err).NotTo(HaveOccurred())
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	// A certificate that can't be verified won't verify on the next attempt either
+	_, err = client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).To(HaveOccurred())
+	Expect(atomic.LoadInt32(&connections)).To(Equal(int32(1)))
+
+	var retryErr *RetryError
+	Expect(errors.As(err, &retryErr)).To(BeFalse())
+
+	// Neither will a URL scheme the HTTP client doesn't support
+	client.baseURL.Scheme = "gopher"
+	_, err = client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).To(HaveOccurred())
+	Expect(err.Error()).To(ContainSubstring("unsupported protocol scheme"))
+	Expect(errors.As(err, &retryErr)).To(BeFalse())
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockBrokenServer()
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	// POSTs are not retried unless the caller opts in
+	_, err := client.DeployBlueprint("/api/v3/cmp/groups/GRP-1/", "BP-esnjtp7u", "", nil, nil)
+	Expect(err).To(HaveOccurred())
+	Expect(len(*requests)).To(Equal(1))
+
+	var retryErr *RetryError
+	Expect(errors.As(err, &retryErr)).To(BeFalse())
+
+	// Opting in retries them like everything else
+	policy := fastRetryPolicy(3)
+	policy.RetryNonIdempotent = true
+	client.SetRetryPolicy(policy)
+
+	_, err = client.DeployBlueprint("/api/v3/cmp/groups/GRP-1/", "BP-esnjtp7u", "", nil, nil)
+	Expect(err).To(HaveOccurred())
+	Expect(len(*requests)).To(Equal(4))
+	Expect(errors.As(err, &retryErr)).To(BeTrue())
+	Expect(retryErr.Attempts).To(Equal(3))
+
+	// Every attempt sent the full payload
+	for _, r := range (*requests)[1:] {
+		Expect(r.Method).To(Equal("POST"))
+		Expect(bodyToString(r.Body)).To(ContainSubstring("/api/v3/cmp/groups/GRP-1/"))
+	}
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockBrokenServer()
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	policy := fastRetryPolicy(5)
+	policy.InitialBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+	client.SetRetryPolicy(policy)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// The backoff is abandoned as soon as the context is done
+	_, err := client.GetBlueprintByIdWithContext(ctx, "BP-esnjtp7u")
+	Expect(err).To(HaveOccurred())
+	Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	Expect(len(*requests)).To(Equal(1))
+}
+
+func TestRetryBackoff(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	policy := &RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+
+	Expect(policy.backoff(1)).To(Equal(100 * time.Millisecond))
+	Expect(policy.backoff(2)).To(Equal(200 * time.Millisecond))
+	Expect(policy.backoff(4)).To(Equal(800 * time.Millisecond))
+	Expect(policy.backoff(5)).To(Equal(time.Second))
+
+	// Jitter only ever shortens the delay
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		Expect(policy.backoff(2)).To(BeNumerically(">=", 100*time.Millisecond))
+		Expect(policy.backoff(2)).To(BeNumerically("<=", 200*time.Millisecond))
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	Expect(parseRetryAfter("")).To(Equal(time.Duration(0)))
+	Expect(parseRetryAfter("garbage")).To(Equal(time.Duration(0)))
+	Expect(parseRetryAfter("120")).To(Equal(2 * time.Minute))
+
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	Expect(parseRetryAfter(date)).To(BeNumerically("~", time.Minute, 2*time.Second))
+}
@@ -1,11 +1,10 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"net/url"
 	"strconv"
 )
 
@@ -16,14 +15,42 @@ type ScriptingPolicyResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the ScriptingPolicies on this page of results.
+func (r *ScriptingPolicyResult) Items() []ScriptingPolicy {
+	return r.Embedded.ScriptingPolicies
+}
+
+type ScriptingDeploymentResult struct {
+	CloudBoltResult
+	Embedded struct {
+		ScriptingDeployments []ScriptingDeployment `json:"scriptingDeployments"`
+	} `json:"_embedded"`
+}
+
+// Items returns the Scripting Deployments on this page of results.
+func (r *ScriptingDeploymentResult) Items() []ScriptingDeployment {
+	return r.Embedded.ScriptingDeployments
+}
+
 type ScriptingPolicy struct {
 	Links *struct {
-		Self      CloudBoltHALItem `json:"self,omitempty"`
-		Workspace CloudBoltHALItem `json:"workspace,omitempty"`
+		Self       CloudBoltHALItem `json:"self,omitempty"`
+		Workspace  CloudBoltHALItem `json:"workspace,omitempty"`
+		Credential CloudBoltHALItem `json:"credential,omitempty"`
 	} `json:"_links,omitempty"`
-	ID          int    `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	WorkspaceURL string `json:"workspace,omitempty"`
+	// Credential is the URL of the module credential used to log in to TargetHost
+	Credential                       string `json:"credential,omitempty"`
+	TargetHost                       string `json:"targetHost,omitempty"`
+	ProvisionScript                  string `json:"provisionScript,omitempty"`
+	ProvisionLaunchCommandTemplate   string `json:"provisionLaunchCommandTemplate,omitempty"`
+	ProvisionSuccessExitCodes        string `json:"provisionSuccessExitCodes,omitempty"`
+	DeprovisionScript                string `json:"deprovisionScript,omitempty"`
+	DeprovisionLaunchCommandTemplate string `json:"deprovisionLaunchCommandTemplate,omitempty"`
+	DeprovisionSuccessExitCodes      string `json:"deprovisionSuccessExitCodes,omitempty"`
 }
 
 type ScriptingDeployment struct {
@@ -33,34 +60,50 @@ type ScriptingDeployment struct {
 		Policy      CloudBoltHALItem `json:"policy,omitempty"`
 		JobMetadata CloudBoltHALItem `json:"jobMetadata,omitempty"`
 	} `json:"_links,omitempty"`
-	ID                  int    `json:"id,omitempty"`
-	PolicyID            int    `json:"policyId,omitempty"`
-	Policy              string `json:"policy,omitempty"`
-	WorkspaceURL        string `json:"workspace,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
+	ID           int    `json:"id,omitempty"`
+	PolicyID     int    `json:"policyId,omitempty"`
+	Policy       string `json:"policy,omitempty"`
+	WorkspaceURL string `json:"workspace,omitempty"`
+	Hostname     string `json:"hostname,omitempty"`
+	// Output holds one entry per script run: a string for plain output,
+	// or a map[string]interface{} for a script that printed JSON.
 	ProvisioningDetails *struct {
-		Status string   `json:"status"`
-		Output []string `json:"output"`
+		Status string        `json:"status"`
+		Output []interface{} `json:"output"`
 	} `json:"provisioningDetails,omitempty"`
 	DeprovisioningDetails *struct {
-		Status string   `json:"status"`
-		Output []string `json:"output"`
+		Status string        `json:"status"`
+		Output []interface{} `json:"output"`
 	} `json:"deprovisioningDetails,omitempty"`
 	Archived           bool                   `json:"archived,omitempty"`
 	TemplateProperties map[string]interface{} `json:"templateProperties"`
 }
 
-func (c *CloudBoltClient) GetScriptingPolicy(name string) (*ScriptingPolicy, error) {
+func (c *CloudBoltClient) GetScriptingPolicy(name string, filters ...*Filter) (*ScriptingPolicy, error) {
+	return c.GetScriptingPolicyWithContext(context.Background(), name, filters...)
+}
+
+// GetScriptingPolicyWithContext is the same as GetScriptingPolicy with a caller-provided context.
+func (c *CloudBoltClient) GetScriptingPolicyWithContext(ctx context.Context, name string, filters ...*Filter) (*ScriptingPolicy, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "scriptingPolicies")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res ScriptingPolicyResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -77,10 +120,15 @@ func (c *CloudBoltClient) GetScriptingPolicy(name string) (*ScriptingPolicy, err
 }
 
 func (c *CloudBoltClient) CreateScriptingDeployment(scriptionDeployment *ScriptingDeployment) (*OneFuseJobStatus, error) {
-	log.Println("onefuse.apiClient: CreateScriptingDeployment")
+	return c.CreateScriptingDeploymentWithContext(context.Background(), scriptionDeployment)
+}
+
+// CreateScriptingDeploymentWithContext is the same as CreateScriptingDeployment with a caller-provided context.
+func (c *CloudBoltClient) CreateScriptingDeploymentWithContext(ctx context.Context, scriptionDeployment *ScriptingDeployment) (*OneFuseJobStatus, error) {
+	c.log().Debug("onefuse.apiClient: CreateScriptingDeployment")
 
 	if scriptionDeployment.WorkspaceURL == "" {
-		workspace, err := c.GetDefaultWorkSpace()
+		workspace, err := c.GetDefaultWorkSpaceWithContext(ctx)
 
 		if err != nil {
 			return nil, err
@@ -107,10 +155,11 @@ func (c *CloudBoltClient) CreateScriptingDeployment(scriptionDeployment *Scripti
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "scriptingDeployments")
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -122,13 +171,22 @@ func (c *CloudBoltClient) CreateScriptingDeployment(scriptionDeployment *Scripti
 }
 
 func (c *CloudBoltClient) GetScriptingDeployment(scriptingDeploymentPath string) (*ScriptingDeployment, error) {
+	return c.GetScriptingDeploymentWithContext(context.Background(), scriptingDeploymentPath)
+}
+
+// GetScriptingDeploymentWithContext is the same as GetScriptingDeployment with a caller-provided context.
+func (c *CloudBoltClient) GetScriptingDeploymentWithContext(ctx context.Context, scriptingDeploymentPath string) (*ScriptingDeployment, error) {
 	apiurl := c.baseURL
 	apiurl.Path = scriptingDeploymentPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -140,13 +198,22 @@ func (c *CloudBoltClient) GetScriptingDeployment(scriptingDeploymentPath string)
 }
 
 func (c *CloudBoltClient) GetScriptingDeploymentById(scriptingDeploymentId string) (*ScriptingDeployment, error) {
+	return c.GetScriptingDeploymentByIdWithContext(context.Background(), scriptingDeploymentId)
+}
+
+// GetScriptingDeploymentByIdWithContext is the same as GetScriptingDeploymentById with a caller-provided context.
+func (c *CloudBoltClient) GetScriptingDeploymentByIdWithContext(ctx context.Context, scriptingDeploymentId string) (*ScriptingDeployment, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "scriptingDeployments", scriptingDeploymentId)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -158,13 +225,19 @@ func (c *CloudBoltClient) GetScriptingDeploymentById(scriptingDeploymentId strin
 }
 
 func (c *CloudBoltClient) DeleteScriptingDeployment(scriptingDeploymentId string) (*OneFuseJobStatus, error) {
+	return c.DeleteScriptingDeploymentWithContext(context.Background(), scriptingDeploymentId)
+}
+
+// DeleteScriptingDeploymentWithContext is the same as DeleteScriptingDeployment with a caller-provided context.
+func (c *CloudBoltClient) DeleteScriptingDeploymentWithContext(ctx context.Context, scriptingDeploymentId string) (*OneFuseJobStatus, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "scriptingDeployments", scriptingDeploymentId)
 
-	resp, err := c.makeRequest("DELETE", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "DELETE", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -174,3 +247,84 @@ func (c *CloudBoltClient) DeleteScriptingDeployment(scriptingDeploymentId string
 
 	return job_status, nil
 }
+
+// ListScriptingPolicies fetches every Scripting Policy the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListScriptingPolicies(opts *ListOptions) ([]ScriptingPolicy, error) {
+	return c.ListScriptingPoliciesWithContext(context.Background(), opts)
+}
+
+// ListScriptingPoliciesWithContext is the same as ListScriptingPolicies with a caller-provided context.
+func (c *CloudBoltClient) ListScriptingPoliciesWithContext(ctx context.Context, opts *ListOptions) ([]ScriptingPolicy, error) {
+	return NewPaginator[ScriptingPolicy, ScriptingPolicyResult](c, c.apiEndpoint("onefuse", "scriptingPolicies"), opts).ListAll(ctx)
+}
+
+// ListScriptingDeployments fetches every Scripting Deployment the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., NewFilter().Policy(2).Archived(false).
+func (c *CloudBoltClient) ListScriptingDeployments(opts *ListOptions) ([]ScriptingDeployment, error) {
+	return c.ListScriptingDeploymentsWithContext(context.Background(), opts)
+}
+
+// ListScriptingDeploymentsWithContext is the same as ListScriptingDeployments with a caller-provided context.
+func (c *CloudBoltClient) ListScriptingDeploymentsWithContext(ctx context.Context, opts *ListOptions) ([]ScriptingDeployment, error) {
+	return NewPaginator[ScriptingDeployment, ScriptingDeploymentResult](c, c.apiEndpoint("onefuse", "scriptingDeployments"), opts).ListAll(ctx)
+}
+
+// CreateScriptingPolicy creates a Scripting Policy in OneFuse and returns it as saved.
+// If newPolicy has no WorkspaceURL it goes in the default workspace.
+func (c *CloudBoltClient) CreateScriptingPolicy(newPolicy *ScriptingPolicy) (*ScriptingPolicy, error) {
+	return c.CreateScriptingPolicyWithContext(context.Background(), newPolicy)
+}
+
+// CreateScriptingPolicyWithContext is the same as CreateScriptingPolicy with a caller-provided context.
+func (c *CloudBoltClient) CreateScriptingPolicyWithContext(ctx context.Context, newPolicy *ScriptingPolicy) (*ScriptingPolicy, error) {
+	var policy ScriptingPolicy
+	err := c.createPolicy(ctx, "scriptingPolicies", &newPolicy.WorkspaceURL, newPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// GetScriptingPolicyByID fetches the Scripting Policy with the given ID.
+func (c *CloudBoltClient) GetScriptingPolicyByID(policyId string) (*ScriptingPolicy, error) {
+	return c.GetScriptingPolicyByIDWithContext(context.Background(), policyId)
+}
+
+// GetScriptingPolicyByIDWithContext is the same as GetScriptingPolicyByID with a caller-provided context.
+func (c *CloudBoltClient) GetScriptingPolicyByIDWithContext(ctx context.Context, policyId string) (*ScriptingPolicy, error) {
+	var policy ScriptingPolicy
+	err := c.getPolicy(ctx, "scriptingPolicies", policyId, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// UpdateScriptingPolicy replaces the Scripting Policy with the given ID and returns it as saved.
+func (c *CloudBoltClient) UpdateScriptingPolicy(policyId string, updatedPolicy *ScriptingPolicy) (*ScriptingPolicy, error) {
+	return c.UpdateScriptingPolicyWithContext(context.Background(), policyId, updatedPolicy)
+}
+
+// UpdateScriptingPolicyWithContext is the same as UpdateScriptingPolicy with a caller-provided context.
+func (c *CloudBoltClient) UpdateScriptingPolicyWithContext(ctx context.Context, policyId string, updatedPolicy *ScriptingPolicy) (*ScriptingPolicy, error) {
+	var policy ScriptingPolicy
+	err := c.updatePolicy(ctx, "scriptingPolicies", policyId, updatedPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// DeleteScriptingPolicy deletes the Scripting Policy with the given ID.
+func (c *CloudBoltClient) DeleteScriptingPolicy(policyId string) error {
+	return c.DeleteScriptingPolicyWithContext(context.Background(), policyId)
+}
+
+// DeleteScriptingPolicyWithContext is the same as DeleteScriptingPolicy with a caller-provided context.
+func (c *CloudBoltClient) DeleteScriptingPolicyWithContext(ctx context.Context, policyId string) error {
+	return c.deletePolicy(ctx, "scriptingPolicies", policyId)
+}
@@ -1,6 +1,7 @@
 package cbclient
 
 import (
+	"encoding/json"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -199,3 +200,230 @@ func TestDeleteScriptingDeployment(t *testing.T) {
 	// The CloudBolt Order object should be parsed correctly
 	verifyJobStatus(jobStatus)
 }
+
+func TestListScriptingPolicies(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListScriptingPolicies)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	policies, err := client.ListScriptingPolicies(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Scripting Policies, get a token
+	// 3. Successfully getting the only page of Scripting Policies
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/scriptingPolicies/"))
+
+	Expect(policies).To(HaveLen(1))
+	Expect(policies[0].ID).To(Equal(901))
+}
+
+func TestListScriptingDeployments(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListScriptingDeployments)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List the ones from policy 2 that aren't archived
+	deployments, err := client.ListScriptingDeployments(&ListOptions{Filter: NewFilter().Workspace(1).Policy(2).Archived(false)})
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Scripting Deployments, get a token
+	// 3. Successfully getting the only page of Scripting Deployments
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/scriptingDeployments/"))
+	Expect((*requests)[2].URL.Query().Get("filter")).To(Equal("workspace__id:1;policy__id:2;archived:False"))
+
+	Expect(deployments).To(HaveLen(1))
+	Expect(deployments[0].ID).To(Equal(67))
+	Expect(deployments[0].ProvisioningDetails.Output).To(HaveLen(2))
+}
+
+func TestCreateScriptingPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleScriptingPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	newPolicy := ScriptingPolicy{
+		Name:                           "My_Scripting_Policy",
+		Description:                    "A Scripting Policy created through automated tests",
+		WorkspaceURL:                   "/api/v3/onefuse/workspaces/2/",
+		Credential:                     "/api/v3/onefuse/moduleCredentials/8328/",
+		TargetHost:                     "mytest.net",
+		ProvisionScript:                "exit 0",
+		ProvisionLaunchCommandTemplate: "sudo /bin/bash {{ scriptName }}",
+		ProvisionSuccessExitCodes:      "0",
+	}
+
+	policy, err := client.CreateScriptingPolicy(&newPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to create, get a token
+	// 3. Successful create
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/scriptingPolicies/"))
+
+	verifyScriptingPolicy(policy)
+}
+
+func TestGetScriptingPolicyByID(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleScriptingPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	policy, err := client.GetScriptingPolicyByID("901")
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get, get a token
+	// 3. Successful Get
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/scriptingPolicies/901/"))
+
+	verifyScriptingPolicy(policy)
+}
+
+func TestUpdateScriptingPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleScriptingPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	updatedPolicy := ScriptingPolicy{
+		Name:                           "My_Scripting_Policy",
+		Description:                    "A Scripting Policy created through automated tests",
+		WorkspaceURL:                   "/api/v3/onefuse/workspaces/2/",
+		Credential:                     "/api/v3/onefuse/moduleCredentials/8328/",
+		TargetHost:                     "mytest.net",
+		ProvisionScript:                "exit 0",
+		ProvisionLaunchCommandTemplate: "sudo /bin/bash {{ scriptName }}",
+		ProvisionSuccessExitCodes:      "0",
+	}
+
+	policy, err := client.UpdateScriptingPolicy("901", &updatedPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to update, get a token
+	// 3. Successful update
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("PUT"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/scriptingPolicies/901/"))
+
+	verifyScriptingPolicy(policy)
+}
+
+func TestDeleteScriptingPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleScriptingPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	err := client.DeleteScriptingPolicy("901")
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to delete, get a token
+	// 3. Successful delete
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("DELETE"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/scriptingPolicies/901/"))
+}
+
+func verifyScriptingPolicy(policy *ScriptingPolicy) {
+	Expect(policy.Links.Self.Href).To(Equal("/api/v3/onefuse/scriptingPolicies/901/"))
+	Expect(policy.Links.Self.Title).To(Equal("My_Scripting_Policy"))
+	Expect(policy.Links.Workspace.Href).To(Equal("/api/v3/onefuse/workspaces/2/"))
+	Expect(policy.ID).To(Equal(901))
+	Expect(policy.Name).To(Equal("My_Scripting_Policy"))
+	Expect(policy.TargetHost).To(Equal("mytest.net"))
+	Expect(policy.ProvisionLaunchCommandTemplate).To(Equal("sudo /bin/bash {{ scriptName }}"))
+	Expect(policy.DeprovisionSuccessExitCodes).To(Equal("0"))
+	Expect(policy.Links.Credential.Href).To(Equal("/api/v3/onefuse/moduleCredentials/8328/"))
+}
+
+func TestScriptingDeploymentOutput(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// The payload OneFuse sends decodes without errors.
+	// Output used to be a []string, which failed on the objects in it.
+	var deployment ScriptingDeployment
+	Expect(json.Unmarshal([]byte(aScriptingDeployment), &deployment)).To(Succeed())
+
+	output := deployment.ProvisioningDetails.Output
+	Expect(output).To(HaveLen(2))
+	Expect(output[0]).To(HaveKeyWithValue("commitId", "b8f2b8b"))
+	Expect(output[1]).To(HaveKeyWithValue("environment", "dev"))
+
+	// Plain lines of output are strings
+	Expect(json.Unmarshal([]byte(`{"provisioningDetails": {"status": "successful", "output": ["done"]}}`), &deployment)).To(Succeed())
+	Expect(deployment.ProvisioningDetails.Output).To(Equal([]interface{}{"done"}))
+}
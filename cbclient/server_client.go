@@ -1,10 +1,11 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
-	"net/url"
+	"strconv"
+	"strings"
 )
 
 // CloudBoltServer stores metadata about servers in CloudBolt.
@@ -23,13 +24,13 @@ type CloudBoltServer struct {
 	} `json:"_links"`
 	ID                   string        `json:"id"`
 	Hostname             string        `json:"hostname"`
-	PowerStatus          string        `json:"powerStatus"`
+	PowerStatus          PowerStatus   `json:"powerStatus"`
 	Status               string        `json:"status"`
 	IP                   string        `json:"ipAddress"`
 	Mac                  string        `json:"mac"`
-	DateAddedToCloudbolt string        `json:"dateAddedToCloudBolt"`
+	DateAddedToCloudbolt CloudBoltTime `json:"dateAddedToCloudBolt"`
 	CPUCount             int           `json:"cpuCount"`
-	MemorySizeGB         string        `json:"memorySizeGb"`
+	MemorySizeGB         SizeGB        `json:"memorySizeGb"`
 	DiskSizeGB           int           `json:"diskSizeGB"`
 	OsFamily             string        `json:"osFamily"`
 	Notes                string        `json:"notes"`
@@ -39,12 +40,210 @@ type CloudBoltServer struct {
 		Password string `json:"password"`
 		Key      string `json:"key"`
 	} `json:"credentials"`
-	RateBreakdown          map[string]interface{}   `json:"rateBreakdown"`
-	Disks                  []map[string]interface{} `json:"disks"`
-	Snapshots              []map[string]interface{} `json:"snapshots"`
-	Networks               []map[string]interface{} `json:"networks"`
-	Attributes             []map[string]interface{} `json:"attributes"`
-	TechSpecificAttributes map[string]interface{}   `json:"techSpecificAttributes"`
+	RateBreakdown          ServerRateBreakdown    `json:"rateBreakdown"`
+	Disks                  []ServerDisk           `json:"disks"`
+	Snapshots              []ServerSnapshot       `json:"snapshots"`
+	Networks               []ServerNetwork        `json:"networks"`
+	Attributes             []ServerAttribute      `json:"attributes"`
+	TechSpecificAttributes TechSpecificAttributes `json:"techSpecificAttributes"`
+}
+
+// ServerRateBreakdown is the cost of a server, as formatted by CloudBolt, e.g., "$ 4.18/month".
+type ServerRateBreakdown struct {
+	Total    string `json:"total"`
+	Hardware string `json:"hardware"`
+	Software string `json:"software"`
+	Extra    string `json:"extra"`
+	// Other holds any other rates CloudBolt sends, by key.
+	Other map[string]string `json:"-"`
+}
+
+// UnmarshalJSON decodes the known rates into their fields and the rest into Other.
+// A rate that isn't a string is kept in Other as its JSON text.
+func (r *ServerRateBreakdown) UnmarshalJSON(data []byte) error {
+	var rates map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return err
+	}
+
+	*r = ServerRateBreakdown{}
+	for key, raw := range rates {
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			value = string(raw)
+		}
+
+		switch key {
+		case "total":
+			r.Total = value
+		case "hardware":
+			r.Hardware = value
+		case "software":
+			r.Software = value
+		case "extra":
+			r.Extra = value
+		default:
+			if r.Other == nil {
+				r.Other = map[string]string{}
+			}
+			r.Other[key] = value
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the rates in the same shape CloudBolt sends them, including Other.
+func (r ServerRateBreakdown) MarshalJSON() ([]byte, error) {
+	rates := map[string]string{}
+	for key, value := range r.Other {
+		rates[key] = value
+	}
+	rates["total"] = r.Total
+	rates["hardware"] = r.Hardware
+	rates["software"] = r.Software
+	rates["extra"] = r.Extra
+
+	return json.Marshal(rates)
+}
+
+// ServerDisk is one of a server's disks.
+// Which fields are set depends on the resource handler, e.g., Datastore for VMware, VolumeType for AWS.
+type ServerDisk struct {
+	UUID             string `json:"uuid"`
+	Name             string `json:"name"`
+	DiskSize         SizeGB `json:"diskSize"`
+	Datastore        string `json:"datastore"`
+	ProvisioningType string `json:"provisioningType"`
+	AvailabilityZone string `json:"availabilityZone"`
+	VolumeType       string `json:"volumeType"`
+	Encrypted        bool   `json:"encrypted"`
+}
+
+// ServerNetwork is one of a server's network interfaces.
+type ServerNetwork struct {
+	Name          string `json:"name"`
+	Network       string `json:"network"`
+	Mac           string `json:"mac"`
+	IP            string `json:"ip"`
+	PrivateIP     string `json:"privateIp"`
+	AdditionalIPs string `json:"additionalIps"`
+}
+
+// ServerSnapshot is a snapshot of a server.
+type ServerSnapshot struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Date        CloudBoltTime `json:"date"`
+}
+
+// ServerAttribute is a custom field value set on a server.
+// Type is the CloudBolt field type, e.g., "STR", "INT" or "BOOL", and Value is decoded to match.
+type ServerAttribute struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// TechSpecificAttributes are the details a resource handler keeps about a server,
+// e.g., "instanceId" for AWS. The keys depend on the resource handler; "type" names which one.
+type TechSpecificAttributes map[string]interface{}
+
+// Type returns the kind of details, e.g., "ec2_server_info".
+func (a TechSpecificAttributes) Type() string {
+	return a.String("type")
+}
+
+// String returns the attribute with the given key, or "" if it isn't set or isn't a string.
+func (a TechSpecificAttributes) String(key string) string {
+	value, _ := a[key].(string)
+	return value
+}
+
+// SizeGB is a size in gigabytes. CloudBolt sends some sizes as numbers and others as strings,
+// e.g., "memorySizeGb": "0.5000"; SizeGB decodes either, and null or "" as 0.
+//
+// A size the SDK can't parse is also 0, with the value CloudBolt sent in Raw,
+// so one odd size doesn't fail decoding the whole server.
+// SizeGB is marshalled as a number, or Raw if it couldn't be parsed.
+type SizeGB struct {
+	GB float64
+	// Raw is the size as CloudBolt sent it, if it couldn't be parsed. It is empty otherwise.
+	Raw string
+}
+
+// UnmarshalJSON decodes a size given as either a number or a string.
+// It doesn't fail: a value it can't parse is kept in Raw.
+func (s *SizeGB) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		*s = SizeGB{Raw: string(data)}
+		return nil
+	}
+
+	switch v := value.(type) {
+	case nil:
+		*s = SizeGB{}
+	case float64:
+		*s = SizeGB{GB: v}
+	case string:
+		if strings.TrimSpace(v) == "" {
+			*s = SizeGB{}
+			return nil
+		}
+
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			*s = SizeGB{Raw: v}
+			return nil
+		}
+		*s = SizeGB{GB: f}
+	default:
+		*s = SizeGB{Raw: string(data)}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the size as a number, or Raw if it couldn't be parsed.
+func (s SizeGB) MarshalJSON() ([]byte, error) {
+	if s.Raw != "" {
+		return json.Marshal(s.Raw)
+	}
+
+	return json.Marshal(s.GB)
+}
+
+// String returns the size in gigabytes, or Raw if it couldn't be parsed.
+func (s SizeGB) String() string {
+	if s.Raw != "" {
+		return s.Raw
+	}
+
+	return strconv.FormatFloat(s.GB, 'f', -1, 64)
+}
+
+// Attribute returns the value of the server's attribute with the given name,
+// and whether the server has it.
+func (s *CloudBoltServer) Attribute(name string) (interface{}, bool) {
+	for _, attribute := range s.Attributes {
+		if attribute.Name == name {
+			return attribute.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+// Disk returns the server's disk with the given name, or nil if it has none.
+func (s *CloudBoltServer) Disk(name string) *ServerDisk {
+	for i := range s.Disks {
+		if s.Disks[i].Name == name {
+			return &s.Disks[i]
+		}
+	}
+
+	return nil
 }
 
 type CloudBoltServerResult struct {
@@ -54,6 +253,11 @@ type CloudBoltServerResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the Servers on this page of results.
+func (r *CloudBoltServerResult) Items() []CloudBoltServer {
+	return r.Embedded.Servers
+}
+
 type CloudBoltDecomServerResult struct {
 	Links struct {
 		Self CloudBoltHALItem `json:"self"`
@@ -64,12 +268,22 @@ type CloudBoltDecomServerResult struct {
 // GetServer fetches a Server object from CloudBolt at the given path
 // - Server Path (serverPath) e.g., "/api/v2/servers/123/"
 func (c *CloudBoltClient) GetServer(serverPath string) (*CloudBoltServer, error) {
+	return c.GetServerWithContext(context.Background(), serverPath)
+}
+
+// GetServerWithContext is the same as GetServer with a caller-provided context.
+func (c *CloudBoltClient) GetServerWithContext(ctx context.Context, serverPath string) (*CloudBoltServer, error) {
 	apiurl := c.baseURL
 	apiurl.Path = serverPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	err = checkHttpStatus(resp)
 	if err != nil {
-		log.Fatalln(err)
 		return nil, err
 	}
 
@@ -81,10 +295,21 @@ func (c *CloudBoltClient) GetServer(serverPath string) (*CloudBoltServer, error)
 }
 
 func (c *CloudBoltClient) GetServerById(id string) (*CloudBoltServer, error) {
+	return c.GetServerByIdWithContext(context.Background(), id)
+}
+
+// GetServerByIdWithContext is the same as GetServerById with a caller-provided context.
+func (c *CloudBoltClient) GetServerByIdWithContext(ctx context.Context, id string) (*CloudBoltServer, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "servers", id)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	err = checkHttpStatus(resp)
 	if err != nil {
 		return nil, err
 	}
@@ -95,12 +320,28 @@ func (c *CloudBoltClient) GetServerById(id string) (*CloudBoltServer, error) {
 	return &svr, nil
 }
 
-func (c *CloudBoltClient) GetServerByHostname(hostname string) (*CloudBoltServer, error) {
+func (c *CloudBoltClient) GetServerByHostname(hostname string, filters ...*Filter) (*CloudBoltServer, error) {
+	return c.GetServerByHostnameWithContext(context.Background(), hostname, filters...)
+}
+
+// GetServerByHostnameWithContext is the same as GetServerByHostname with a caller-provided context.
+func (c *CloudBoltClient) GetServerByHostnameWithContext(ctx context.Context, hostname string, filters ...*Filter) (*CloudBoltServer, error) {
+	query, err := NewFilter().Eq("hostname", hostname).Eq("status", "ACTIVE").And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("cmp", "servers")
-	apiurl.RawQuery = fmt.Sprintf("filter=hostname:%s;status:ACTIVE", url.QueryEscape(hostname))
+	apiurl.RawQuery = query
+
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	err = checkHttpStatus(resp)
 	if err != nil {
 		return nil, err
 	}
@@ -128,6 +369,11 @@ func (c *CloudBoltClient) GetServerByHostname(hostname string) (*CloudBoltServer
 }
 
 func (c *CloudBoltClient) DecomServer(serverId string) (*CloudBoltDecomServerResult, error) {
+	return c.DecomServerWithContext(context.Background(), serverId)
+}
+
+// DecomServerWithContext is the same as DecomServer with a caller-provided context.
+func (c *CloudBoltClient) DecomServerWithContext(ctx context.Context, serverId string) (*CloudBoltDecomServerResult, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint(
 		"cmp",
@@ -136,7 +382,13 @@ func (c *CloudBoltClient) DecomServer(serverId string) (*CloudBoltDecomServerRes
 		"decommission",
 	)
 
-	resp, err := c.makeRequest("POST", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	err = checkHttpStatus(resp)
 	if err != nil {
 		return nil, err
 	}
@@ -147,3 +399,19 @@ func (c *CloudBoltClient) DecomServer(serverId string) (*CloudBoltDecomServerRes
 
 	return &decomResult, nil
 }
+
+// ListServers fetches every Server the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by hostname or status.
+// Hostname matches exactly; use IContains for a partial match:
+//
+//	servers, err := client.ListServers(&cbclient.ListOptions{
+//		Filter: cbclient.NewFilter().IContains("hostname", "web").Status("ACTIVE"),
+//	})
+func (c *CloudBoltClient) ListServers(opts *ListOptions) ([]CloudBoltServer, error) {
+	return c.ListServersWithContext(context.Background(), opts)
+}
+
+// ListServersWithContext is the same as ListServers with a caller-provided context.
+func (c *CloudBoltClient) ListServersWithContext(ctx context.Context, opts *ListOptions) ([]CloudBoltServer, error) {
+	return NewPaginator[CloudBoltServer, CloudBoltServerResult](c, c.apiEndpoint("cmp", "servers"), opts).ListAll(ctx)
+}
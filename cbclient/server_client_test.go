@@ -1,7 +1,9 @@
 package cbclient
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -45,10 +47,10 @@ func TestGetServer(t *testing.T) {
 	Expect(cbServer.IP).To(Equal("3.17.176.101"))
 	Expect(cbServer.Status).To(Equal("ACTIVE"))
 	Expect(cbServer.Mac).To(Equal("02:99:e2:0f:18:b2"))
-	Expect(cbServer.PowerStatus).To(Equal("POWERON"))
-	Expect(cbServer.DateAddedToCloudbolt).To(Equal("2022-04-08 11:55:07.056038"))
+	Expect(cbServer.PowerStatus).To(Equal(PowerStatusOn))
+	Expect(cbServer.DateAddedToCloudbolt.Time).To(Equal(time.Date(2022, 4, 8, 11, 55, 7, 56038000, time.UTC)))
 	Expect(cbServer.CPUCount).To(Equal(1))
-	Expect(cbServer.MemorySizeGB).To(Equal("0.5000"))
+	Expect(cbServer.MemorySizeGB).To(Equal(SizeGB{GB: 0.5}))
 	Expect(cbServer.DiskSizeGB).To(Equal(8))
 	Expect(cbServer.Notes).To(Equal(""))
 	Expect(len(cbServer.Labels)).To(Equal(0))
@@ -59,6 +61,94 @@ func TestGetServer(t *testing.T) {
 	Expect(len(cbServer.Disks)).To(Equal(1))
 	Expect(len(cbServer.Networks)).To(Equal(1))
 	Expect(cbServer.TechSpecificAttributes).To(Not(BeNil()))
+
+	// The sub-collections should be typed
+	Expect(cbServer.RateBreakdown.Total).To(Equal("$ 4.18/month"))
+	Expect(cbServer.Disks[0].Name).To(Equal("vol-037494719ec2192d1"))
+	Expect(cbServer.Disks[0].DiskSize).To(Equal(SizeGB{GB: 8}))
+	Expect(cbServer.Disks[0].VolumeType).To(Equal("standard"))
+	Expect(cbServer.Disk("vol-037494719ec2192d1")).To(Equal(&cbServer.Disks[0]))
+	Expect(cbServer.Disk("vol-missing")).To(BeNil())
+	Expect(cbServer.Networks[0].Network).To(Equal("subnet-214ab049"))
+	Expect(cbServer.Networks[0].IP).To(Equal("3.17.176.215"))
+	Expect(cbServer.Networks[0].PrivateIP).To(Equal("172.31.13.128"))
+	Expect(cbServer.Networks[0].Mac).To(Equal("02:99:e2:0f:18:b2"))
+	Expect(cbServer.Snapshots).To(BeEmpty())
+	Expect(cbServer.TechSpecificAttributes.Type()).To(Equal("ec2_server_info"))
+	Expect(cbServer.TechSpecificAttributes.String("instanceId")).To(Equal("i-094c9eed88b8acaa0"))
+	Expect(cbServer.TechSpecificAttributes.String("elasticIp")).To(Equal(""))
+
+	value, ok := cbServer.Attribute("ebs_volume_type")
+	Expect(ok).To(BeTrue())
+	Expect(value).To(Equal("standard"))
+	value, ok = cbServer.Attribute("delete_ebs_volumes_on_termination")
+	Expect(ok).To(BeTrue())
+	Expect(value).To(Equal(true))
+	_, ok = cbServer.Attribute("missing")
+	Expect(ok).To(BeFalse())
+}
+
+func TestSizeGBUnmarshalJSON(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	var sizes []SizeGB
+	err := json.Unmarshal([]byte(`["0.5000", 8, 16.5, " 2 ", "", null]`), &sizes)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(sizes).To(Equal([]SizeGB{{GB: 0.5}, {GB: 8}, {GB: 16.5}, {GB: 2}, {}, {}}))
+
+	// A size that can't be parsed is 0, and kept as it was sent
+	var disk ServerDisk
+	err = json.Unmarshal([]byte(`{"name": "disk-1", "diskSize": "half"}`), &disk)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(disk.Name).To(Equal("disk-1"))
+	Expect(disk.DiskSize).To(Equal(SizeGB{Raw: "half"}))
+	Expect(disk.DiskSize.String()).To(Equal("half"))
+
+	var size SizeGB
+	Expect(json.Unmarshal([]byte(`true`), &size)).To(Succeed())
+	Expect(size).To(Equal(SizeGB{Raw: "true"}))
+
+	// Sizes are marshalled the way they were sent
+	data, err := json.Marshal([]SizeGB{{GB: 0.5}, {Raw: "half"}})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(data).To(MatchJSON(`[0.5, "half"]`))
+}
+
+func TestServerRateBreakdownUnmarshalJSON(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	var rates ServerRateBreakdown
+	err := json.Unmarshal([]byte(`{
+		"total": "$ 5.18/month",
+		"hardware": "$ 4.18/month",
+		"software": "-",
+		"extra": "-",
+		"storage": "$ 1.00/month",
+		"discount": 0
+	}`), &rates)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(rates.Total).To(Equal("$ 5.18/month"))
+	Expect(rates.Hardware).To(Equal("$ 4.18/month"))
+	Expect(rates.Software).To(Equal("-"))
+	Expect(rates.Extra).To(Equal("-"))
+	Expect(rates.Other).To(Equal(map[string]string{
+		"storage":  "$ 1.00/month",
+		"discount": "0",
+	}))
+
+	// The rates CloudBolt sent are marshalled back, other ones included
+	data, err := json.Marshal(rates)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(data).To(MatchJSON(`{
+		"total": "$ 5.18/month",
+		"hardware": "$ 4.18/month",
+		"software": "-",
+		"extra": "-",
+		"storage": "$ 1.00/month",
+		"discount": "0"
+	}`))
 }
 
 func TestGetServerById(t *testing.T) {
@@ -100,10 +190,10 @@ func TestGetServerById(t *testing.T) {
 	Expect(cbServer.IP).To(Equal("3.17.176.101"))
 	Expect(cbServer.Status).To(Equal("ACTIVE"))
 	Expect(cbServer.Mac).To(Equal("02:99:e2:0f:18:b2"))
-	Expect(cbServer.PowerStatus).To(Equal("POWERON"))
-	Expect(cbServer.DateAddedToCloudbolt).To(Equal("2022-04-08 11:55:07.056038"))
+	Expect(cbServer.PowerStatus).To(Equal(PowerStatusOn))
+	Expect(cbServer.DateAddedToCloudbolt.Time).To(Equal(time.Date(2022, 4, 8, 11, 55, 7, 56038000, time.UTC)))
 	Expect(cbServer.CPUCount).To(Equal(1))
-	Expect(cbServer.MemorySizeGB).To(Equal("0.5000"))
+	Expect(cbServer.MemorySizeGB).To(Equal(SizeGB{GB: 0.5}))
 	Expect(cbServer.DiskSizeGB).To(Equal(8))
 	Expect(cbServer.Notes).To(Equal(""))
 	Expect(len(cbServer.Labels)).To(Equal(0))
@@ -155,10 +245,10 @@ func TestGetServerByHostname(t *testing.T) {
 	Expect(cbServer.IP).To(Equal("3.17.176.101"))
 	Expect(cbServer.Status).To(Equal("ACTIVE"))
 	Expect(cbServer.Mac).To(Equal("02:99:e2:0f:18:b2"))
-	Expect(cbServer.PowerStatus).To(Equal("POWERON"))
-	Expect(cbServer.DateAddedToCloudbolt).To(Equal("2022-04-08 11:55:07.056038"))
+	Expect(cbServer.PowerStatus).To(Equal(PowerStatusOn))
+	Expect(cbServer.DateAddedToCloudbolt.Time).To(Equal(time.Date(2022, 4, 8, 11, 55, 7, 56038000, time.UTC)))
 	Expect(cbServer.CPUCount).To(Equal(1))
-	Expect(cbServer.MemorySizeGB).To(Equal("0.5000"))
+	Expect(cbServer.MemorySizeGB).To(Equal(SizeGB{GB: 0.5}))
 	Expect(cbServer.DiskSizeGB).To(Equal(8))
 	Expect(cbServer.Notes).To(Equal(""))
 	Expect(len(cbServer.Labels)).To(Equal(0))
@@ -240,3 +330,35 @@ func TestDecomServerJobResponse(t *testing.T) {
 	Expect(order.Links.Self.Title).To(Equal("Delete Server Job 502"))
 	Expect(order.ID).To(Equal("JOB-80uh0rmr"))
 }
+
+func TestListServers(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListServers)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List the active servers, newest first
+	filter := NewFilter().Eq("status", "ACTIVE").OrderBy("-date_added_to_cloudbolt")
+	servers, err := client.ListServers(&ListOptions{PageSize: 50, Filter: filter})
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Servers, get a token
+	// 3. Successfully getting the only page of Servers
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/servers/"))
+	Expect((*requests)[2].URL.Query().Get("filter")).To(Equal("status:ACTIVE"))
+	Expect((*requests)[2].URL.Query().Get("ordering")).To(Equal("-date_added_to_cloudbolt"))
+	Expect((*requests)[2].URL.Query().Get("page_size")).To(Equal("50"))
+
+	Expect(servers).To(HaveLen(1))
+	Expect(servers[0].Hostname).To(Equal("myawsinstance1"))
+}
@@ -1,11 +1,10 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"net/url"
 	"strconv"
 )
 
@@ -16,15 +15,39 @@ type ServiceNowCMDBPolicyResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the ServiceNowCMDBPolicies on this page of results.
+func (r *ServiceNowCMDBPolicyResult) Items() []ServiceNowCMDBPolicy {
+	return r.Embedded.ServiceNowCMDBPolicies
+}
+
+type ServicenowCMDBDeploymentResult struct {
+	CloudBoltResult
+	Embedded struct {
+		ServicenowCMDBDeployments []ServicenowCMDBDeployment `json:"servicenowCMDBDeployments"`
+	} `json:"_embedded"`
+}
+
+// Items returns the ServiceNow CMDB Deployments on this page of results.
+func (r *ServicenowCMDBDeploymentResult) Items() []ServicenowCMDBDeployment {
+	return r.Embedded.ServicenowCMDBDeployments
+}
+
 type ServiceNowCMDBPolicy struct {
 	Links *struct {
 		Self      CloudBoltHALItem `json:"self,omitempty"`
 		Workspace CloudBoltHALItem `json:"workspace,omitempty"`
 		Endpoint  CloudBoltHALItem `json:"endpoint,omitempty"`
 	} `json:"_links,omitempty"`
-	ID          int    `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	WorkspaceURL string `json:"workspace,omitempty"`
+	// Endpoint is the URL of the ServiceNow endpoint, e.g., "/api/v3/onefuse/endpoints/7723/"
+	Endpoint string `json:"endpoint,omitempty"`
+	// The templates are JSON, e.g., `{"items": [{"className": "cmdb_ci_linux_server", "values": {...}}]}`
+	ProvisionTemplate   string `json:"provisionTemplate,omitempty"`
+	UpdateTemplate      string `json:"updateTemplate,omitempty"`
+	DeprovisionTemplate string `json:"deprovisionTemplate,omitempty"`
 }
 
 type ServicenowCMDBDeployment struct {
@@ -44,17 +67,31 @@ type ServicenowCMDBDeployment struct {
 	TemplateProperties     map[string]interface{}   `json:"templateProperties"`
 }
 
-func (c *CloudBoltClient) GetServiceNowCMDBPolicy(name string) (*ServiceNowCMDBPolicy, error) {
+func (c *CloudBoltClient) GetServiceNowCMDBPolicy(name string, filters ...*Filter) (*ServiceNowCMDBPolicy, error) {
+	return c.GetServiceNowCMDBPolicyWithContext(context.Background(), name, filters...)
+}
+
+// GetServiceNowCMDBPolicyWithContext is the same as GetServiceNowCMDBPolicy with a caller-provided context.
+func (c *CloudBoltClient) GetServiceNowCMDBPolicyWithContext(ctx context.Context, name string, filters ...*Filter) (*ServiceNowCMDBPolicy, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "servicenowCMDBPolicies")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res ServiceNowCMDBPolicyResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -71,10 +108,15 @@ func (c *CloudBoltClient) GetServiceNowCMDBPolicy(name string) (*ServiceNowCMDBP
 }
 
 func (c *CloudBoltClient) CreateServicenowCMDBDeployment(snowDeployment *ServicenowCMDBDeployment) (*OneFuseJobStatus, error) {
-	log.Println("onefuse.apiClient: CreateServicenowCMDBDeployment")
+	return c.CreateServicenowCMDBDeploymentWithContext(context.Background(), snowDeployment)
+}
+
+// CreateServicenowCMDBDeploymentWithContext is the same as CreateServicenowCMDBDeployment with a caller-provided context.
+func (c *CloudBoltClient) CreateServicenowCMDBDeploymentWithContext(ctx context.Context, snowDeployment *ServicenowCMDBDeployment) (*OneFuseJobStatus, error) {
+	c.log().Debug("onefuse.apiClient: CreateServicenowCMDBDeployment")
 
 	if snowDeployment.WorkspaceURL == "" {
-		workspace, err := c.GetDefaultWorkSpace()
+		workspace, err := c.GetDefaultWorkSpaceWithContext(ctx)
 
 		if err != nil {
 			return nil, err
@@ -101,10 +143,11 @@ func (c *CloudBoltClient) CreateServicenowCMDBDeployment(snowDeployment *Service
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "servicenowCMDBDeployments")
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -116,13 +159,22 @@ func (c *CloudBoltClient) CreateServicenowCMDBDeployment(snowDeployment *Service
 }
 
 func (c *CloudBoltClient) GetServicenowCMDBDeployment(snowDeploymentPath string) (*ServicenowCMDBDeployment, error) {
+	return c.GetServicenowCMDBDeploymentWithContext(context.Background(), snowDeploymentPath)
+}
+
+// GetServicenowCMDBDeploymentWithContext is the same as GetServicenowCMDBDeployment with a caller-provided context.
+func (c *CloudBoltClient) GetServicenowCMDBDeploymentWithContext(ctx context.Context, snowDeploymentPath string) (*ServicenowCMDBDeployment, error) {
 	apiurl := c.baseURL
 	apiurl.Path = snowDeploymentPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -134,13 +186,22 @@ func (c *CloudBoltClient) GetServicenowCMDBDeployment(snowDeploymentPath string)
 }
 
 func (c *CloudBoltClient) GetServicenowCMDBDeploymentById(snowDeploymentId string) (*ServicenowCMDBDeployment, error) {
+	return c.GetServicenowCMDBDeploymentByIdWithContext(context.Background(), snowDeploymentId)
+}
+
+// GetServicenowCMDBDeploymentByIdWithContext is the same as GetServicenowCMDBDeploymentById with a caller-provided context.
+func (c *CloudBoltClient) GetServicenowCMDBDeploymentByIdWithContext(ctx context.Context, snowDeploymentId string) (*ServicenowCMDBDeployment, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "servicenowCMDBDeployments", snowDeploymentId)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -152,13 +213,19 @@ func (c *CloudBoltClient) GetServicenowCMDBDeploymentById(snowDeploymentId strin
 }
 
 func (c *CloudBoltClient) DeleteServicenowCMDBDeployment(snowDeploymentId string) (*OneFuseJobStatus, error) {
+	return c.DeleteServicenowCMDBDeploymentWithContext(context.Background(), snowDeploymentId)
+}
+
+// DeleteServicenowCMDBDeploymentWithContext is the same as DeleteServicenowCMDBDeployment with a caller-provided context.
+func (c *CloudBoltClient) DeleteServicenowCMDBDeploymentWithContext(ctx context.Context, snowDeploymentId string) (*OneFuseJobStatus, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "servicenowCMDBDeployments", snowDeploymentId)
 
-	resp, err := c.makeRequest("DELETE", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "DELETE", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -168,3 +235,84 @@ func (c *CloudBoltClient) DeleteServicenowCMDBDeployment(snowDeploymentId string
 
 	return job_status, nil
 }
+
+// ListServiceNowCMDBPolicies fetches every ServiceNow CMDB Policy the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListServiceNowCMDBPolicies(opts *ListOptions) ([]ServiceNowCMDBPolicy, error) {
+	return c.ListServiceNowCMDBPoliciesWithContext(context.Background(), opts)
+}
+
+// ListServiceNowCMDBPoliciesWithContext is the same as ListServiceNowCMDBPolicies with a caller-provided context.
+func (c *CloudBoltClient) ListServiceNowCMDBPoliciesWithContext(ctx context.Context, opts *ListOptions) ([]ServiceNowCMDBPolicy, error) {
+	return NewPaginator[ServiceNowCMDBPolicy, ServiceNowCMDBPolicyResult](c, c.apiEndpoint("onefuse", "servicenowCMDBPolicies"), opts).ListAll(ctx)
+}
+
+// ListServicenowCMDBDeployments fetches every ServiceNow CMDB Deployment the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., NewFilter().Policy(2).Archived(false).
+func (c *CloudBoltClient) ListServicenowCMDBDeployments(opts *ListOptions) ([]ServicenowCMDBDeployment, error) {
+	return c.ListServicenowCMDBDeploymentsWithContext(context.Background(), opts)
+}
+
+// ListServicenowCMDBDeploymentsWithContext is the same as ListServicenowCMDBDeployments with a caller-provided context.
+func (c *CloudBoltClient) ListServicenowCMDBDeploymentsWithContext(ctx context.Context, opts *ListOptions) ([]ServicenowCMDBDeployment, error) {
+	return NewPaginator[ServicenowCMDBDeployment, ServicenowCMDBDeploymentResult](c, c.apiEndpoint("onefuse", "servicenowCMDBDeployments"), opts).ListAll(ctx)
+}
+
+// CreateServiceNowCMDBPolicy creates a ServiceNow CMDB Policy in OneFuse and returns it as saved.
+// If newPolicy has no WorkspaceURL it goes in the default workspace.
+func (c *CloudBoltClient) CreateServiceNowCMDBPolicy(newPolicy *ServiceNowCMDBPolicy) (*ServiceNowCMDBPolicy, error) {
+	return c.CreateServiceNowCMDBPolicyWithContext(context.Background(), newPolicy)
+}
+
+// CreateServiceNowCMDBPolicyWithContext is the same as CreateServiceNowCMDBPolicy with a caller-provided context.
+func (c *CloudBoltClient) CreateServiceNowCMDBPolicyWithContext(ctx context.Context, newPolicy *ServiceNowCMDBPolicy) (*ServiceNowCMDBPolicy, error) {
+	var policy ServiceNowCMDBPolicy
+	err := c.createPolicy(ctx, "servicenowCMDBPolicies", &newPolicy.WorkspaceURL, newPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// GetServiceNowCMDBPolicyByID fetches the ServiceNow CMDB Policy with the given ID.
+func (c *CloudBoltClient) GetServiceNowCMDBPolicyByID(policyId string) (*ServiceNowCMDBPolicy, error) {
+	return c.GetServiceNowCMDBPolicyByIDWithContext(context.Background(), policyId)
+}
+
+// GetServiceNowCMDBPolicyByIDWithContext is the same as GetServiceNowCMDBPolicyByID with a caller-provided context.
+func (c *CloudBoltClient) GetServiceNowCMDBPolicyByIDWithContext(ctx context.Context, policyId string) (*ServiceNowCMDBPolicy, error) {
+	var policy ServiceNowCMDBPolicy
+	err := c.getPolicy(ctx, "servicenowCMDBPolicies", policyId, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// UpdateServiceNowCMDBPolicy replaces the ServiceNow CMDB Policy with the given ID and returns it as saved.
+func (c *CloudBoltClient) UpdateServiceNowCMDBPolicy(policyId string, updatedPolicy *ServiceNowCMDBPolicy) (*ServiceNowCMDBPolicy, error) {
+	return c.UpdateServiceNowCMDBPolicyWithContext(context.Background(), policyId, updatedPolicy)
+}
+
+// UpdateServiceNowCMDBPolicyWithContext is the same as UpdateServiceNowCMDBPolicy with a caller-provided context.
+func (c *CloudBoltClient) UpdateServiceNowCMDBPolicyWithContext(ctx context.Context, policyId string, updatedPolicy *ServiceNowCMDBPolicy) (*ServiceNowCMDBPolicy, error) {
+	var policy ServiceNowCMDBPolicy
+	err := c.updatePolicy(ctx, "servicenowCMDBPolicies", policyId, updatedPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// DeleteServiceNowCMDBPolicy deletes the ServiceNow CMDB Policy with the given ID.
+func (c *CloudBoltClient) DeleteServiceNowCMDBPolicy(policyId string) error {
+	return c.DeleteServiceNowCMDBPolicyWithContext(context.Background(), policyId)
+}
+
+// DeleteServiceNowCMDBPolicyWithContext is the same as DeleteServiceNowCMDBPolicy with a caller-provided context.
+func (c *CloudBoltClient) DeleteServiceNowCMDBPolicyWithContext(ctx context.Context, policyId string) error {
+	return c.deletePolicy(ctx, "servicenowCMDBPolicies", policyId)
+}
@@ -202,3 +202,202 @@ func TestDeleteServicenowCMDBDeployment(t *testing.T) {
 	// The CloudBolt Order object should be parsed correctly
 	verifyJobStatus(jobStatus)
 }
+
+func TestListServiceNowCMDBPolicies(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListServiceNowCMDBPolicies)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	policies, err := client.ListServiceNowCMDBPolicies(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the ServiceNow CMDB Policies, get a token
+	// 3. Successfully getting the only page of ServiceNow CMDB Policies
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/servicenowCMDBPolicies/"))
+
+	Expect(policies).To(HaveLen(1))
+	Expect(policies[0].ID).To(Equal(224))
+}
+
+func TestListServicenowCMDBDeployments(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListServicenowCMDBDeployments)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	deployments, err := client.ListServicenowCMDBDeployments(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the ServiceNow CMDB Deployments, get a token
+	// 3. Successfully getting the only page of ServiceNow CMDB Deployments
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/servicenowCMDBDeployments/"))
+
+	Expect(deployments).To(HaveLen(1))
+	Expect(deployments[0].ID).To(Equal(24))
+}
+
+func TestCreateServiceNowCMDBPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleServiceNowCMDBPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	newPolicy := ServiceNowCMDBPolicy{
+		Name:              "My_SNOW_CMDB_Policy",
+		Description:       "A ServiceNow CMDB Policy",
+		WorkspaceURL:      "/api/v3/onefuse/workspaces/2/",
+		Endpoint:          "/api/v3/onefuse/endpoints/7723/",
+		ProvisionTemplate: `{"items": [{"className": "cmdb_ci_linux_server", "values": {}}]}`,
+	}
+
+	policy, err := client.CreateServiceNowCMDBPolicy(&newPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to create, get a token
+	// 3. Successful create
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/servicenowCMDBPolicies/"))
+
+	verifyServiceNowCMDBPolicy(policy)
+}
+
+func TestGetServiceNowCMDBPolicyByID(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleServiceNowCMDBPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	policy, err := client.GetServiceNowCMDBPolicyByID("224")
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get, get a token
+	// 3. Successful Get
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/servicenowCMDBPolicies/224/"))
+
+	verifyServiceNowCMDBPolicy(policy)
+}
+
+func TestUpdateServiceNowCMDBPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleServiceNowCMDBPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	updatedPolicy := ServiceNowCMDBPolicy{
+		Name:              "My_SNOW_CMDB_Policy",
+		Description:       "A ServiceNow CMDB Policy",
+		WorkspaceURL:      "/api/v3/onefuse/workspaces/2/",
+		Endpoint:          "/api/v3/onefuse/endpoints/7723/",
+		ProvisionTemplate: `{"items": [{"className": "cmdb_ci_linux_server", "values": {}}]}`,
+	}
+
+	policy, err := client.UpdateServiceNowCMDBPolicy("224", &updatedPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to update, get a token
+	// 3. Successful update
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("PUT"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/servicenowCMDBPolicies/224/"))
+
+	verifyServiceNowCMDBPolicy(policy)
+}
+
+func TestDeleteServiceNowCMDBPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleServiceNowCMDBPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	err := client.DeleteServiceNowCMDBPolicy("224")
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to delete, get a token
+	// 3. Successful delete
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("DELETE"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/servicenowCMDBPolicies/224/"))
+}
+
+func verifyServiceNowCMDBPolicy(policy *ServiceNowCMDBPolicy) {
+	Expect(policy.Links.Self.Href).To(Equal("/api/v3/onefuse/servicenowCMDBPolicies/224/"))
+	Expect(policy.Links.Self.Title).To(Equal("My_SNOW_CMDB_Policy"))
+	Expect(policy.Links.Workspace.Href).To(Equal("/api/v3/onefuse/workspaces/2/"))
+	Expect(policy.ID).To(Equal(224))
+	Expect(policy.Name).To(Equal("My_SNOW_CMDB_Policy"))
+	Expect(policy.Links.Endpoint.Href).To(Equal("/api/v3/onefuse/endpoints/7723/"))
+	Expect(policy.ProvisionTemplate).To(ContainSubstring("cmdb_ci_linux_server"))
+	Expect(policy.DeprovisionTemplate).To(ContainSubstring("retired"))
+}
@@ -1,9 +1,9 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 type StaticPropertySetResult struct {
@@ -13,6 +13,11 @@ type StaticPropertySetResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the PropertySets on this page of results.
+func (r *StaticPropertySetResult) Items() []StaticPropertySet {
+	return r.Embedded.PropertySets
+}
+
 type StaticPropertySet struct {
 	Links *struct {
 		Self      CloudBoltHALItem `json:"self,omitempty"`
@@ -25,17 +30,31 @@ type StaticPropertySet struct {
 	Raw         string
 }
 
-func (c *CloudBoltClient) GetStaticPropertySet(name string) (*StaticPropertySet, error) {
+func (c *CloudBoltClient) GetStaticPropertySet(name string, filters ...*Filter) (*StaticPropertySet, error) {
+	return c.GetStaticPropertySetWithContext(context.Background(), name, filters...)
+}
+
+// GetStaticPropertySetWithContext is the same as GetStaticPropertySet with a caller-provided context.
+func (c *CloudBoltClient) GetStaticPropertySetWithContext(ctx context.Context, name string, filters ...*Filter) (*StaticPropertySet, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "propertySets")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res StaticPropertySetResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -56,3 +75,14 @@ func (c *CloudBoltClient) GetStaticPropertySet(name string) (*StaticPropertySet,
 
 	return &res.Embedded.PropertySets[0], nil
 }
+
+// ListStaticPropertySets fetches every Static Property Set the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListStaticPropertySets(opts *ListOptions) ([]StaticPropertySet, error) {
+	return c.ListStaticPropertySetsWithContext(context.Background(), opts)
+}
+
+// ListStaticPropertySetsWithContext is the same as ListStaticPropertySets with a caller-provided context.
+func (c *CloudBoltClient) ListStaticPropertySetsWithContext(ctx context.Context, opts *ListOptions) ([]StaticPropertySet, error) {
+	return NewPaginator[StaticPropertySet, StaticPropertySetResult](c, c.apiEndpoint("onefuse", "propertySets"), opts).ListAll(ctx)
+}
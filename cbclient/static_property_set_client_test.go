@@ -44,3 +44,31 @@ func TestGetStaticPropertySet(t *testing.T) {
 	Expect(propertySet.Properties["product"]).To(Equal("OneFuse"))
 	Expect(propertySet.Properties["organization"]).To(Equal("CloudBolt Software"))
 }
+
+func TestListStaticPropertySets(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListStaticPropertySets)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	propertySets, err := client.ListStaticPropertySets(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Static Property Sets, get a token
+	// 3. Successfully getting the only page of Static Property Sets
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/propertySets/"))
+
+	Expect(propertySets).To(HaveLen(1))
+	Expect(propertySets[0].ID).To(Equal(168))
+}
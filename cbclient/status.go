@@ -0,0 +1,255 @@
+package cbclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// The statuses below are what CloudBolt and OneFuse send today.
+// Each type unmarshals any value: known values are matched ignoring case,
+// and anything else is kept as-is, so a new status in a later CloudBolt release doesn't break decoding.
+
+// JobStatus is the Status of a CloudBoltJob.
+type JobStatus string
+
+const (
+	JobStatusInitialized JobStatus = "INIT"
+	JobStatusQueued      JobStatus = "QUEUED"
+	JobStatusPending     JobStatus = "PENDING"
+	JobStatusRunning     JobStatus = "RUNNING"
+	JobStatusToCancel    JobStatus = "TO_CANCEL"
+	JobStatusSuccess     JobStatus = "SUCCESS"
+	JobStatusWarning     JobStatus = "WARNING"
+	JobStatusFailure     JobStatus = "FAILURE"
+	JobStatusCanceled    JobStatus = "CANCELED"
+)
+
+var jobStatuses = []JobStatus{
+	JobStatusInitialized,
+	JobStatusQueued,
+	JobStatusPending,
+	JobStatusRunning,
+	JobStatusToCancel,
+	JobStatusSuccess,
+	JobStatusWarning,
+	JobStatusFailure,
+	JobStatusCanceled,
+}
+
+// IsTerminal reports whether the job has finished: SUCCESS, WARNING, FAILURE or CANCELED.
+func (s JobStatus) IsTerminal() bool {
+	switch s {
+	case JobStatusSuccess, JobStatusWarning, JobStatusFailure, JobStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsSuccess reports whether the job finished without failing. WARNING counts as a success.
+func (s JobStatus) IsSuccess() bool {
+	return s == JobStatusSuccess || s == JobStatusWarning
+}
+
+// IsKnown reports whether s is one of the JobStatus constants.
+func (s JobStatus) IsKnown() bool {
+	return isKnownEnum(s, jobStatuses)
+}
+
+func (s JobStatus) String() string {
+	return string(s)
+}
+
+// UnmarshalJSON accepts any JSON value. See the note on the status types.
+func (s *JobStatus) UnmarshalJSON(data []byte) error {
+	*s = unmarshalEnum(data, jobStatuses)
+	return nil
+}
+
+// ExecutionState is the ExecutionState of a CloudBoltJob.
+// CloudBolt leaves it empty for most jobs, and doesn't document its other values,
+// so unlike the other status types it has no constants beyond ExecutionStateNone.
+type ExecutionState string
+
+const (
+	ExecutionStateNone ExecutionState = ""
+)
+
+func (s ExecutionState) String() string {
+	return string(s)
+}
+
+// UnmarshalJSON accepts any JSON value. See the note on the status types.
+func (s *ExecutionState) UnmarshalJSON(data []byte) error {
+	*s = unmarshalEnum[ExecutionState](data, nil)
+	return nil
+}
+
+// OrderStatus is the Status of a CloudBoltOrder.
+type OrderStatus string
+
+const (
+	OrderStatusCart     OrderStatus = "CART"
+	OrderStatusPending  OrderStatus = "PENDING"
+	OrderStatusDenied   OrderStatus = "DENIED"
+	OrderStatusActive   OrderStatus = "ACTIVE"
+	OrderStatusSuccess  OrderStatus = "SUCCESS"
+	OrderStatusWarning  OrderStatus = "WARNING"
+	OrderStatusFailure  OrderStatus = "FAILURE"
+	OrderStatusCanceled OrderStatus = "CANCELED"
+)
+
+var orderStatuses = []OrderStatus{
+	OrderStatusCart,
+	OrderStatusPending,
+	OrderStatusDenied,
+	OrderStatusActive,
+	OrderStatusSuccess,
+	OrderStatusWarning,
+	OrderStatusFailure,
+	OrderStatusCanceled,
+}
+
+// IsTerminal reports whether the order is done for good: SUCCESS, WARNING, FAILURE, DENIED or CANCELED.
+func (s OrderStatus) IsTerminal() bool {
+	switch s {
+	case OrderStatusSuccess, OrderStatusWarning, OrderStatusFailure, OrderStatusDenied, OrderStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsSuccess reports whether the order finished without failing. WARNING counts as a success.
+func (s OrderStatus) IsSuccess() bool {
+	return s == OrderStatusSuccess || s == OrderStatusWarning
+}
+
+// IsKnown reports whether s is one of the OrderStatus constants.
+func (s OrderStatus) IsKnown() bool {
+	return isKnownEnum(s, orderStatuses)
+}
+
+func (s OrderStatus) String() string {
+	return string(s)
+}
+
+// UnmarshalJSON accepts any JSON value. See the note on the status types.
+func (s *OrderStatus) UnmarshalJSON(data []byte) error {
+	*s = unmarshalEnum(data, orderStatuses)
+	return nil
+}
+
+// OneFuseJobState is the JobState of a OneFuseJobStatus.
+type OneFuseJobState string
+
+const (
+	OneFuseJobStatePending    OneFuseJobState = "Pending"
+	OneFuseJobStateInProgress OneFuseJobState = "InProgress"
+	OneFuseJobStateSuccessful OneFuseJobState = "Successful"
+	OneFuseJobStateFailed     OneFuseJobState = "Failed"
+)
+
+var oneFuseJobStates = []OneFuseJobState{
+	OneFuseJobStatePending,
+	OneFuseJobStateInProgress,
+	OneFuseJobStateSuccessful,
+	OneFuseJobStateFailed,
+}
+
+// IsTerminal reports whether the job has finished: Successful or Failed.
+func (s OneFuseJobState) IsTerminal() bool {
+	return s == OneFuseJobStateSuccessful || s == OneFuseJobStateFailed
+}
+
+// IsSuccess reports whether the job finished successfully.
+func (s OneFuseJobState) IsSuccess() bool {
+	return s == OneFuseJobStateSuccessful
+}
+
+// IsKnown reports whether s is one of the OneFuseJobState constants.
+func (s OneFuseJobState) IsKnown() bool {
+	return isKnownEnum(s, oneFuseJobStates)
+}
+
+func (s OneFuseJobState) String() string {
+	return string(s)
+}
+
+// UnmarshalJSON accepts any JSON value. See the note on the status types.
+func (s *OneFuseJobState) UnmarshalJSON(data []byte) error {
+	*s = unmarshalEnum(data, oneFuseJobStates)
+	return nil
+}
+
+// PowerStatus is the PowerStatus of a CloudBoltServer.
+type PowerStatus string
+
+const (
+	PowerStatusOn        PowerStatus = "POWERON"
+	PowerStatusOff       PowerStatus = "POWEROFF"
+	PowerStatusSuspended PowerStatus = "SUSPENDED"
+	PowerStatusUnknown   PowerStatus = "UNKNOWN"
+)
+
+var powerStatuses = []PowerStatus{
+	PowerStatusOn,
+	PowerStatusOff,
+	PowerStatusSuspended,
+	PowerStatusUnknown,
+}
+
+// IsOn reports whether the server is powered on.
+func (s PowerStatus) IsOn() bool {
+	return s == PowerStatusOn
+}
+
+// IsKnown reports whether s is one of the PowerStatus constants.
+func (s PowerStatus) IsKnown() bool {
+	return isKnownEnum(s, powerStatuses)
+}
+
+func (s PowerStatus) String() string {
+	return string(s)
+}
+
+// UnmarshalJSON accepts any JSON value. See the note on the status types.
+func (s *PowerStatus) UnmarshalJSON(data []byte) error {
+	*s = unmarshalEnum(data, powerStatuses)
+	return nil
+}
+
+// unmarshalEnum decodes a status from any JSON value without failing:
+// null is empty, a string matching one of known (ignoring case) is that constant,
+// any other string is kept as-is, and anything else is kept as its JSON text.
+func unmarshalEnum[T ~string](data []byte, known []T) T {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return ""
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return T(data)
+	}
+
+	for _, k := range known {
+		if strings.EqualFold(s, string(k)) {
+			return k
+		}
+	}
+
+	return T(s)
+}
+
+// isKnownEnum reports whether s is one of known.
+func isKnownEnum[T ~string](s T, known []T) bool {
+	for _, k := range known {
+		if s == k {
+			return true
+		}
+	}
+
+	return false
+}
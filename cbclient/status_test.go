@@ -0,0 +1,95 @@
+package cbclient
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestJobStatus(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	Expect(JobStatusRunning.IsTerminal()).To(BeFalse())
+	Expect(JobStatusQueued.IsTerminal()).To(BeFalse())
+	Expect(JobStatusSuccess.IsTerminal()).To(BeTrue())
+	Expect(JobStatusWarning.IsTerminal()).To(BeTrue())
+	Expect(JobStatusFailure.IsTerminal()).To(BeTrue())
+	Expect(JobStatusCanceled.IsTerminal()).To(BeTrue())
+
+	Expect(JobStatusSuccess.IsSuccess()).To(BeTrue())
+	Expect(JobStatusWarning.IsSuccess()).To(BeTrue())
+	Expect(JobStatusFailure.IsSuccess()).To(BeFalse())
+	Expect(JobStatusRunning.IsSuccess()).To(BeFalse())
+}
+
+func TestOrderStatus(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	Expect(OrderStatusPending.IsTerminal()).To(BeFalse())
+	Expect(OrderStatusActive.IsTerminal()).To(BeFalse())
+	Expect(OrderStatusDenied.IsTerminal()).To(BeTrue())
+	Expect(OrderStatusSuccess.IsTerminal()).To(BeTrue())
+
+	Expect(OrderStatusWarning.IsSuccess()).To(BeTrue())
+	Expect(OrderStatusDenied.IsSuccess()).To(BeFalse())
+}
+
+func TestOneFuseJobState(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	Expect(OneFuseJobStateInProgress.IsTerminal()).To(BeFalse())
+	Expect(OneFuseJobStateFailed.IsTerminal()).To(BeTrue())
+	Expect(OneFuseJobStateFailed.IsSuccess()).To(BeFalse())
+	Expect(OneFuseJobStateSuccessful.IsSuccess()).To(BeTrue())
+}
+
+func TestStatusUnmarshalJSON(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	var job struct {
+		Status         JobStatus       `json:"status"`
+		ExecutionState ExecutionState  `json:"executionState"`
+		JobState       OneFuseJobState `json:"jobState"`
+		PowerStatus    PowerStatus     `json:"powerStatus"`
+		OrderStatus    OrderStatus     `json:"orderStatus"`
+	}
+
+	// Known values are matched ignoring case
+	err := json.Unmarshal([]byte(`{
+		"status": "success",
+		"executionState": "",
+		"jobState": "inprogress",
+		"powerStatus": "POWEROFF",
+		"orderStatus": "Pending"
+	}`), &job)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(job.Status).To(Equal(JobStatusSuccess))
+	Expect(job.ExecutionState).To(Equal(ExecutionStateNone))
+	Expect(job.JobState).To(Equal(OneFuseJobStateInProgress))
+	Expect(job.PowerStatus).To(Equal(PowerStatusOff))
+	Expect(job.PowerStatus.IsOn()).To(BeFalse())
+	Expect(job.OrderStatus).To(Equal(OrderStatusPending))
+
+	// Unknown values are kept, and don't fail decoding
+	err = json.Unmarshal([]byte(`{
+		"status": "PAUSED",
+		"executionState": 3,
+		"jobState": null,
+		"powerStatus": "HIBERNATING",
+		"orderStatus": "SUBMITTED"
+	}`), &job)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(job.Status).To(Equal(JobStatus("PAUSED")))
+	Expect(job.Status.IsKnown()).To(BeFalse())
+	Expect(job.Status.IsTerminal()).To(BeFalse())
+	Expect(job.ExecutionState).To(Equal(ExecutionState("3")))
+	Expect(job.JobState).To(Equal(OneFuseJobState("")))
+	Expect(job.PowerStatus).To(Equal(PowerStatus("HIBERNATING")))
+	Expect(job.PowerStatus.IsKnown()).To(BeFalse())
+	Expect(job.OrderStatus.String()).To(Equal("SUBMITTED"))
+}
@@ -135,3 +135,37 @@ func bodyForGetMicrosoftADPolicy(i int) string {
 		aMicrosoftADPolicy,
 	)[i]
 }
+
+func responsesForListADPolicies(i int) (string, int) {
+	return bodyForListADPolicies(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListADPolicies(i int) string {
+	return missingTokenBodyPattern(
+		aADPolicyList,
+	)[i]
+}
+
+const aComputerAccountList string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/microsoftADComputerAccounts/?page=1",
+            "title": "List of Microsoft AD Computer Accounts - Page 1 of 1"
+        }
+    },
+    "total": 1,
+    "count": 1,
+    "_embedded": {
+        "microsoftADComputerAccounts": [` + aComputerAccount + `]
+    }
+}`
+
+func responsesForListMicrosoftADComputerAccounts(i int) (string, int) {
+	return bodyForListMicrosoftADComputerAccounts(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListMicrosoftADComputerAccounts(i int) string {
+	return missingTokenBodyPattern(
+		aComputerAccountList,
+	)[i]
+}
@@ -42,6 +42,34 @@ const aAnsibleTowerPolicyList string = `{
     }
 }
 `
+
+const aAnsibleTowerPolicy string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/ansibleTowerPolicies/6/",
+            "title": "My_Ansible_Tower_Policy"
+        },
+        "workspace": {
+            "href": "/api/v3/onefuse/workspaces/2/",
+            "title": "Default"
+        },
+        "endpoint": {
+            "href": "/api/v3/onefuse/endpoints/192/",
+            "title": "My_Ansible_Tower_Endpoint"
+        }
+    },
+    "id": 6,
+    "name": "My_Ansible_Tower_Policy",
+    "description": "An Ansible Policy created through automated tests",
+    "organizationName": "{{org_name}}",
+    "provisioningJobTemplates": "[{\"name\": \"{{job_template_name}}\"}]",
+    "deprovisioningJobTemplates": "[{\"name\": \"{{job_template_name}}\"}]",
+    "verifyPromptOnLaunchForLimit": false,
+    "machineCredentialOverride": null,
+    "extraVarsOverride": null,
+    "inventoryName": null,
+    "groups": null
+}`
 const aAnsibleTowerDeployment = `{
     "_links": {
       "self": {
@@ -96,3 +124,47 @@ func bodyForGetAnsibleTowerDeployment(i int) string {
 		aAnsibleTowerDeployment,
 	)[i]
 }
+
+func responsesForListAnsibleTowerPolicies(i int) (string, int) {
+	return bodyForListAnsibleTowerPolicies(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListAnsibleTowerPolicies(i int) string {
+	return missingTokenBodyPattern(
+		aAnsibleTowerPolicyList,
+	)[i]
+}
+
+const aAnsibleTowerDeploymentList string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/ansibleTowerDeployments/?page=1",
+            "title": "List of Ansible Tower Deployments - Page 1 of 1"
+        }
+    },
+    "total": 1,
+    "count": 1,
+    "_embedded": {
+        "ansibleTowerDeployments": [` + aAnsibleTowerDeployment + `]
+    }
+}`
+
+func responsesForListAnsibleTowerDeployments(i int) (string, int) {
+	return bodyForListAnsibleTowerDeployments(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListAnsibleTowerDeployments(i int) string {
+	return missingTokenBodyPattern(
+		aAnsibleTowerDeploymentList,
+	)[i]
+}
+
+func responsesForSingleAnsibleTowerPolicy(i int) (string, int) {
+	return bodyForSingleAnsibleTowerPolicy(i), missingTokenStatusPattern(i)
+}
+
+func bodyForSingleAnsibleTowerPolicy(i int) string {
+	return missingTokenBodyPattern(
+		aAnsibleTowerPolicy,
+	)[i]
+}
@@ -275,3 +275,105 @@ func bodyForDeployBlueprint(i int) string {
 		anOrder,
 	)[i]
 }
+
+func responsesForDeployBlueprintTwice(i int) (string, int) {
+	return bodyForDeployBlueprintTwice(i), missingTokenStatusPattern(i)
+}
+
+func bodyForDeployBlueprintTwice(i int) string {
+	return missingTokenBodyPattern(
+		anOrder,
+		anOrder,
+	)[i]
+}
+
+func responsesForListBlueprints(i int) (string, int) {
+	return bodyForListBlueprints(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListBlueprints(i int) string {
+	return missingTokenBodyPattern(
+		aBlueprintList,
+	)[i]
+}
+
+const aBlueprintDeploymentSchema string = `{
+    "parameters": {
+        "owner": {
+            "label": "Owner",
+            "description": "Who to bill for the resource",
+            "type": "STR",
+            "required": true,
+            "default": null,
+            "options": []
+        },
+        "tier": {
+            "label": "Tier",
+            "description": "",
+            "type": "STR",
+            "required": true,
+            "default": "dev",
+            "options": ["dev", "test", "prod"]
+        }
+    },
+    "deploymentItems": {
+        "server-bdi-743tlxxu": {
+            "tierType": "server",
+            "environments": [
+                {
+                    "href": "/api/v3/cmp/environments/ENV-1tytr2pu/",
+                    "title": "AWS us-west-2"
+                }
+            ],
+            "osBuilds": [
+                {
+                    "href": "/api/v3/cmp/osBuilds/OSB-z69k4a4x/",
+                    "title": "CentOS 7"
+                }
+            ],
+            "parameters": {
+                "instance_type": {
+                    "label": "Instance Type",
+                    "description": "",
+                    "type": "STR",
+                    "required": true,
+                    "default": null,
+                    "options": ["t2.nano", "t2.micro"]
+                },
+                "disk_size": {
+                    "label": "Disk Size",
+                    "description": "In GB",
+                    "type": "INT",
+                    "required": false,
+                    "default": 10,
+                    "options": []
+                },
+                "monitored": {
+                    "label": "Monitored",
+                    "description": "",
+                    "type": "BOOL",
+                    "required": false,
+                    "default": false,
+                    "options": []
+                }
+            }
+        },
+        "plugin-bdi-olk0xwve": {
+            "tierType": "plugin",
+            "environments": [],
+            "osBuilds": [],
+            "parameters": {}
+        }
+    }
+}`
+
+func responsesForDescribeBlueprint(i int) (string, int) {
+	return bodyForDescribeBlueprint(i), missingTokenStatusPattern(i)
+}
+
+func bodyForDescribeBlueprint(i int) string {
+	return missingTokenBodyPattern(
+		aBlueprint,
+		aBlueprintDeploymentSchema,
+	)[i]
+}
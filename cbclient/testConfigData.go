@@ -0,0 +1,33 @@
+package cbclient
+
+const anINIConfig string = `
+# CloudBolt profiles
+[default]
+url = https://cloudbolt.intranet
+username = aUser
+password = "aPassword"
+domain = mydomain.com
+
+[profile prod]
+protocol = https
+host = cloudbolt.prod.intranet
+port = 8443
+username = prodUser
+password-file = %s
+timeout = 30
+; the proxy is only needed in prod
+proxy = http://proxy.intranet:3128
+`
+
+const aYAMLConfig string = `
+default:
+  url: https://cloudbolt.intranet
+  username: aUser
+  password: aPassword
+  domain: mydomain.com
+prod:
+  host: cloudbolt.prod.intranet
+  port: 8443
+  token_file: %s
+  timeout: 1m30s
+`
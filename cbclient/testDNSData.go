@@ -49,6 +49,41 @@ const aDNSPolicyList string = `{
     }
 }`
 
+const aDNSPolicy string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/dnsPolicies/9/",
+            "title": "my_infoblox_dns_policy"
+        },
+        "workspace": {
+            "href": "/api/v3/onefuse/workspaces/2/",
+            "title": "Default"
+        },
+        "endpoint": {
+            "href": "/api/v3/onefuse/endpoints/14/",
+            "title": "my_infoblox_dns_policy_Endpoint"
+        }
+    },
+    "id": 9,
+    "type": "infoblox",
+    "name": "my_infoblox_dns_policy",
+    "description": "An Infoblox DNS Policy created through automated tests",
+    "createARecord": true,
+    "preValidateARecord": false,
+    "postValidateARecord": false,
+    "createPtrRecord": false,
+    "preValidatePtrRecord": false,
+    "postValidatePtrRecord": false,
+    "createCNameRecord": false,
+    "preValidateCNameRecord": false,
+    "postValidateCNameRecord": false,
+    "postValidationSleepSeconds": "5",
+    "validationTimeoutSeconds": "180",
+    "hostnameOverride": null,
+    "createHostRecord": false,
+    "removeFixedAddressRecord": false
+}`
+
 const aDNSReservation string = `{
   "_links": {
     "self": {
@@ -108,3 +143,47 @@ func bodyForGetDNSReservation(i int) string {
 		aDNSReservation,
 	)[i]
 }
+
+func responsesForListDNSPolicies(i int) (string, int) {
+	return bodyForListDNSPolicies(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListDNSPolicies(i int) string {
+	return missingTokenBodyPattern(
+		aDNSPolicyList,
+	)[i]
+}
+
+const aDNSReservationList string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/dnsReservations/?page=1",
+            "title": "List of DNS Reservations - Page 1 of 1"
+        }
+    },
+    "total": 1,
+    "count": 1,
+    "_embedded": {
+        "dnsReservations": [` + aDNSReservation + `]
+    }
+}`
+
+func responsesForListDNSReservations(i int) (string, int) {
+	return bodyForListDNSReservations(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListDNSReservations(i int) string {
+	return missingTokenBodyPattern(
+		aDNSReservationList,
+	)[i]
+}
+
+func responsesForSingleDNSPolicy(i int) (string, int) {
+	return bodyForSingleDNSPolicy(i), missingTokenStatusPattern(i)
+}
+
+func bodyForSingleDNSPolicy(i int) string {
+	return missingTokenBodyPattern(
+		aDNSPolicy,
+	)[i]
+}
@@ -94,3 +94,97 @@ func bodyForAuthWrappedRequestWithToken(i int) string {
 		`{"foo": "bar"}`,
 	}[i]
 }
+
+const aNotFoundResponseBody string = `{
+	"detail": "Not found."
+}`
+
+const aConflictResponseBody string = `{
+	"code": 409,
+	"errors": [
+		{"message": "Name already in use"},
+		{"message": "Policy is locked"}
+	]
+}`
+
+func notFoundStatusPattern(i int) int {
+	switch i {
+	case 0:
+		return 401
+	case 1:
+		return 200
+	default:
+		return 404
+	}
+}
+
+/*
+HTTP response script for TestAPIErrorNotFound() API calls
+*/
+func responsesForNotFound(i int) (string, int) {
+	return bodyForNotFound(i), notFoundStatusPattern(i)
+}
+
+// Every request after authenticating gets a 404, no matter how many requests
+// the method under test makes.
+func bodyForNotFound(i int) string {
+	if i < 2 {
+		return missingTokenBodyPattern()[i]
+	}
+
+	return aNotFoundResponseBody
+}
+
+/*
+HTTP response script for TestAPIError() API calls
+*/
+func responsesForAPIError(i int) (string, int) {
+	return bodyForAPIError(i), []int{401, 200, 409}[i]
+}
+
+func bodyForAPIError(i int) string {
+	return missingTokenBodyPattern(
+		aConflictResponseBody,
+	)[i]
+}
+
+const aBadRequestResponseBody string = `{
+	"group": ["This field is required."]
+}`
+
+const aForbiddenResponseBody string = `{
+	"detail": "You do not have permission to perform this action."
+}`
+
+const anExpiredTokenResponseBody string = `{
+	"detail": "Signature has expired."
+}`
+
+/*
+HTTP response script for TestDeployBlueprintNotResentOnBadRequest() API calls
+*/
+func responsesForDeployBlueprintBadRequest(i int) (string, int) {
+	return bodyForDeployBlueprintBadRequest(i), []int{401, 200, 400, 400}[i]
+}
+
+func bodyForDeployBlueprintBadRequest(i int) string {
+	return missingTokenBodyPattern(
+		aBadRequestResponseBody,
+		aBadRequestResponseBody,
+	)[i]
+}
+
+/*
+HTTP response script for TestAuthWrappedRequestExpiredToken() API calls
+*/
+func responsesForExpiredToken(i int) (string, int) {
+	return bodyForExpiredToken(i), []int{403, 200, 200}[i]
+}
+
+func bodyForExpiredToken(i int) string {
+	return []string{
+		anExpiredTokenResponseBody,
+		anAuthRequestResponseBody,
+		`{"foo": "bar"}`,
+	}[i]
+}
@@ -325,3 +325,13 @@ func bodyForGetEnvironmentById(i int) string {
 		aEnvironment,
 	)[i]
 }
+
+func responsesForListEnvironments(i int) (string, int) {
+	return bodyForListEnvironments(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListEnvironments(i int) string {
+	return missingTokenBodyPattern(
+		aEnvironmentList,
+	)[i]
+}
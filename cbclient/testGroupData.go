@@ -139,3 +139,13 @@ func bodyForGetGroup(i int) string {
 		aGroup, // Necessary?
 	)[i]
 }
+
+func responsesForListGroups(i int) (string, int) {
+	return bodyForListGroups(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListGroups(i int) string {
+	return missingTokenBodyPattern(
+		listOfGroups,
+	)[i]
+}
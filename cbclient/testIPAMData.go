@@ -51,6 +51,43 @@ const aIPAMPolicyList string = `{
     }
 }`
 
+const aIPAMPolicy string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/ipamPolicies/3/",
+            "title": "MY_IPAM_POLICY"
+        },
+        "workspace": {
+            "href": "/api/v3/onefuse/workspaces/2/",
+            "title": "Default"
+        },
+        "endpoint": {
+            "href": "/api/v3/onefuse/endpoints/8/",
+            "title": "MY_IPAM_POLICY_Endpoint"
+        }
+    },
+    "id": 3,
+    "type": "solarwinds",
+    "name": "MY_IPAM_POLICY",
+    "description": "An IPAM Policy created through automated tests",
+    "hostnameOverride": "{{request.hostname}}",
+    "updateConflictNameWithDns": false,
+    "primaryDns": null,
+    "secondaryDns": null,
+    "dnsSuffix": null,
+    "dnsSearchSuffixes": null,
+    "nicLabel": null,
+    "conflictNameTemplate": null,
+    "subnets": [
+        {
+            "subnet": "10.192.50.0/24",
+            "gateway": "10.192.50.1",
+            "network": "mynetwork_10.192.50.0_24",
+            "netmask": "255.255.255.0"
+        }
+    ]
+}`
+
 const aIPAMReservation string = `{
     "_links": {
       "self": {
@@ -102,3 +139,47 @@ func bodyForGetIPAMReservation(i int) string {
 		aIPAMReservation,
 	)[i]
 }
+
+func responsesForListIPAMPolicies(i int) (string, int) {
+	return bodyForListIPAMPolicies(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListIPAMPolicies(i int) string {
+	return missingTokenBodyPattern(
+		aIPAMPolicyList,
+	)[i]
+}
+
+const aIPAMReservationList string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/ipamReservations/?page=1",
+            "title": "List of IPAM Reservations - Page 1 of 1"
+        }
+    },
+    "total": 1,
+    "count": 1,
+    "_embedded": {
+        "ipamReservations": [` + aIPAMReservation + `]
+    }
+}`
+
+func responsesForListIPAMReservations(i int) (string, int) {
+	return bodyForListIPAMReservations(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListIPAMReservations(i int) string {
+	return missingTokenBodyPattern(
+		aIPAMReservationList,
+	)[i]
+}
+
+func responsesForSingleIPAMPolicy(i int) (string, int) {
+	return bodyForSingleIPAMPolicy(i), missingTokenStatusPattern(i)
+}
+
+func bodyForSingleIPAMPolicy(i int) string {
+	return missingTokenBodyPattern(
+		aIPAMPolicy,
+	)[i]
+}
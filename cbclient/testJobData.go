@@ -186,3 +186,180 @@ func bodyForGetJobStatus(i int) string {
 		aJobStatus,
 	)[i]
 }
+
+const aJobList string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/jobs/?page=1",
+            "title": "List of Jobs - Page 1 of 1"
+        }
+    },
+    "total": 1,
+    "count": 1,
+    "_embedded": {
+        "jobs": [` + aJob + `]
+    }
+}`
+
+func responsesForListJobs(i int) (string, int) {
+	return bodyForListJobs(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListJobs(i int) string {
+	return missingTokenBodyPattern(
+		aJobList,
+	)[i]
+}
+
+const aProvisionServerSubjob string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/jobs/JOB-kb0tuw1e/",
+            "title": "Provision Server Job 1012"
+        },
+        "parent": {
+            "href": "/api/v3/cmp/jobs/JOB-9nrax3gb/",
+            "title": "Deploy Blueprint Job 1011"
+        },
+        "subjobs": [],
+        "prerequisite": {},
+        "dependentJobs": [
+            {
+                "href": "/api/v3/cmp/jobs/JOB-t2js3lwf/",
+                "title": "My Action Job 1013"
+            }
+        ]
+    },
+    "id": "JOB-kb0tuw1e",
+    "type": "provision_server",
+    "status": "SUCCESS"
+}`
+
+const anActionSubjob string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/jobs/JOB-t2js3lwf/",
+            "title": "My Action Job 1013"
+        },
+        "parent": {
+            "href": "/api/v3/cmp/jobs/JOB-9nrax3gb/",
+            "title": "Deploy Blueprint Job 1011"
+        },
+        "subjobs": [],
+        "prerequisite": {
+            "href": "/api/v3/cmp/jobs/JOB-kb0tuw1e/",
+            "title": "Provision Server Job 1012"
+        },
+        "dependentJobs": []
+    },
+    "id": "JOB-t2js3lwf",
+    "type": "orchestration_hook",
+    "status": "FAILURE",
+    "errors": "Action failed"
+}`
+
+const aJobToCancel string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/jobs/JOB-9nrax3gb/",
+            "title": "Deploy Blueprint Job 1011"
+        }
+    },
+    "id": "JOB-9nrax3gb",
+    "type": "deploy_blueprint",
+    "status": "TO_CANCEL",
+    "canBeRequeued": false
+}`
+
+const aRequeuedJob string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/jobs/JOB-r4q8ue2x/",
+            "title": "Deploy Blueprint Job 1020"
+        }
+    },
+    "id": "JOB-r4q8ue2x",
+    "type": "deploy_blueprint",
+    "status": "QUEUED",
+    "canBeRequeued": false
+}`
+
+func responsesForGetJobTree(i int) (string, int) {
+	return bodyForGetJobTree(i), missingTokenStatusPattern(i)
+}
+
+func bodyForGetJobTree(i int) string {
+	return missingTokenBodyPattern(
+		aJob,
+		aProvisionServerSubjob,
+		anActionSubjob,
+	)[i]
+}
+
+func responsesForCancelJob(i int) (string, int) {
+	return bodyForCancelJob(i), missingTokenStatusPattern(i)
+}
+
+func bodyForCancelJob(i int) string {
+	return missingTokenBodyPattern(
+		aJobToCancel,
+	)[i]
+}
+
+func responsesForRequeueJob(i int) (string, int) {
+	return bodyForRequeueJob(i), missingTokenStatusPattern(i)
+}
+
+func bodyForRequeueJob(i int) string {
+	return missingTokenBodyPattern(
+		aRequeuedJob,
+	)[i]
+}
+
+// The job actions below are accepted, but CloudBolt's response doesn't describe the Job.
+
+func responsesForCancelJobNoContent(i int) (string, int) {
+	statuses := []int{401, 200, 204, 200}
+	return bodyForCancelJobNoContent(i), statuses[i]
+}
+
+func bodyForCancelJobNoContent(i int) string {
+	return missingTokenBodyPattern(
+		"",
+		aJobToCancel,
+	)[i]
+}
+
+func responsesForCancelJobStatusOnly(i int) (string, int) {
+	return bodyForCancelJobStatusOnly(i), missingTokenStatusPattern(i)
+}
+
+func bodyForCancelJobStatusOnly(i int) string {
+	return missingTokenBodyPattern(
+		`{"status": "SUCCESS"}`,
+		aJobToCancel,
+	)[i]
+}
+
+func responsesForCancelJobUnreadable(i int) (string, int) {
+	statuses := []int{401, 200, 200, 500}
+	return bodyForCancelJobUnreadable(i), statuses[i]
+}
+
+func bodyForCancelJobUnreadable(i int) string {
+	return missingTokenBodyPattern(
+		"<html>Job canceled</html>",
+		`{"error": "Internal Server Error"}`,
+	)[i]
+}
+
+func responsesForRequeueJobUnreadable(i int) (string, int) {
+	statuses := []int{401, 200, 202}
+	return bodyForRequeueJobUnreadable(i), statuses[i]
+}
+
+func bodyForRequeueJobUnreadable(i int) string {
+	return missingTokenBodyPattern(
+		"Job requeued",
+	)[i]
+}
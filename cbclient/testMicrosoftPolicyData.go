@@ -52,3 +52,13 @@ func bodyForGetMicrosoftEndpoint(i int) string {
 		aMicrosoftEndpointList,
 	)[i]
 }
+
+func responsesForListEndpoints(i int) (string, int) {
+	return bodyForListEndpoints(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListEndpoints(i int) string {
+	return missingTokenBodyPattern(
+		aMicrosoftEndpointList,
+	)[i]
+}
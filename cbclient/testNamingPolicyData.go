@@ -39,6 +39,31 @@ const aNamingPolicyList string = `{
     }
 }`
 
+const aNamingPolicy string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/namingPolicies/1/",
+            "title": "My_Naming_Policy"
+        },
+        "workspace": {
+            "href": "/api/v3/onefuse/workspaces/2/",
+            "title": "Default"
+        },
+        "namingSequences": [
+            {
+                "href": "/api/v3/onefuse/namingSequences/61/",
+                "title": "My_NamingSequence"
+            }
+        ],
+        "validationPolicies": []
+    },
+    "name": "My_Naming_Policy",
+    "id": 1,
+    "template": "{{sequence.QA_BASE10_NamingSequence10807033}}",
+    "description": "A Naming Policy",
+    "dnsSuffix": "test.com"
+}`
+
 const aCustomName string = `{
     "_links": {
       "self": {
@@ -82,3 +107,47 @@ func bodyForGetCustomName(i int) string {
 		aCustomName,
 	)[i]
 }
+
+func responsesForListNamingPolicies(i int) (string, int) {
+	return bodyForListNamingPolicies(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListNamingPolicies(i int) string {
+	return missingTokenBodyPattern(
+		aNamingPolicyList,
+	)[i]
+}
+
+const aCustomNameList string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/customNames/?page=1",
+            "title": "List of Custom Names - Page 1 of 1"
+        }
+    },
+    "total": 1,
+    "count": 1,
+    "_embedded": {
+        "customNames": [` + aCustomName + `]
+    }
+}`
+
+func responsesForListCustomNames(i int) (string, int) {
+	return bodyForListCustomNames(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListCustomNames(i int) string {
+	return missingTokenBodyPattern(
+		aCustomNameList,
+	)[i]
+}
+
+func responsesForSingleNamingPolicy(i int) (string, int) {
+	return bodyForSingleNamingPolicy(i), missingTokenStatusPattern(i)
+}
+
+func bodyForSingleNamingPolicy(i int) string {
+	return missingTokenBodyPattern(
+		aNamingPolicy,
+	)[i]
+}
@@ -83,3 +83,13 @@ func bodyForGetOSBuildById(i int) string {
 		aOSBuild,
 	)[i]
 }
+
+func responsesForListOSBuilds(i int) (string, int) {
+	return bodyForListOSBuilds(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListOSBuilds(i int) string {
+	return missingTokenBodyPattern(
+		aOSBuildList,
+	)[i]
+}
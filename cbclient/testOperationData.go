@@ -0,0 +1,129 @@
+package cbclient
+
+const aPendingIPAMJobStatus string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/jobStatus/3281/",
+            "title": "Job Metadata Record id 3281"
+        },
+        "jobMetadata": {
+            "href": "/api/v3/onefuse/jobMetadata/3281/",
+            "title": "Job Metadata Record id 3281"
+        }
+    },
+    "jobStateDescription": "Pending",
+    "id": 3281,
+    "jobType": "Provision IPAM",
+    "jobState": "Pending",
+    "jobTrackingId": "5f2c1a40-6f0a-4a0e-9d6a-3c1f0e8b1d22"
+}`
+
+const aRunningIPAMJobStatus string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/jobStatus/3281/",
+            "title": "Job Metadata Record id 3281"
+        },
+        "jobMetadata": {
+            "href": "/api/v3/onefuse/jobMetadata/3281/",
+            "title": "Job Metadata Record id 3281"
+        }
+    },
+    "jobStateDescription": "Reserving an IP address",
+    "id": 3281,
+    "jobType": "Provision IPAM",
+    "jobState": "InProgress",
+    "jobTrackingId": "5f2c1a40-6f0a-4a0e-9d6a-3c1f0e8b1d22"
+}`
+
+const aSuccessfulIPAMJobStatus string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/jobStatus/3281/",
+            "title": "Job Metadata Record id 3281"
+        },
+        "jobMetadata": {
+            "href": "/api/v3/onefuse/jobMetadata/3281/",
+            "title": "Job Metadata Record id 3281"
+        },
+        "managedObject": {
+            "href": "/api/v3/onefuse/ipamReservations/10/",
+            "title": "test010"
+        }
+    },
+    "jobStateDescription": "Successful",
+    "id": 3281,
+    "jobType": "Provision IPAM",
+    "jobState": "Successful",
+    "jobTrackingId": "5f2c1a40-6f0a-4a0e-9d6a-3c1f0e8b1d22"
+}`
+
+const aFailedIPAMJobStatus string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/jobStatus/3281/",
+            "title": "Job Metadata Record id 3281"
+        },
+        "jobMetadata": {
+            "href": "/api/v3/onefuse/jobMetadata/3281/",
+            "title": "Job Metadata Record id 3281"
+        }
+    },
+    "jobStateDescription": "Failed",
+    "id": 3281,
+    "jobType": "Provision IPAM",
+    "jobState": "Failed",
+    "jobTrackingId": "5f2c1a40-6f0a-4a0e-9d6a-3c1f0e8b1d22",
+    "errorDetails": {
+        "code": 500,
+        "errors": [
+            {
+                "message": "No free IP addresses in 10.30.32.0/24"
+            }
+        ]
+    }
+}`
+
+/*
+HTTP response script for TestOperationWait() API calls
+*/
+func responsesForOperationWait(i int) (string, int) {
+	return bodyForOperationWait(i), missingTokenStatusPattern(i)
+}
+
+func bodyForOperationWait(i int) string {
+	return missingTokenBodyPattern(
+		aPendingIPAMJobStatus,
+		aRunningIPAMJobStatus,
+		aSuccessfulIPAMJobStatus,
+		aIPAMReservation,
+	)[i]
+}
+
+/*
+HTTP response script for TestOperationFailed() API calls
+*/
+func responsesForOperationFailed(i int) (string, int) {
+	return bodyForOperationFailed(i), missingTokenStatusPattern(i)
+}
+
+func bodyForOperationFailed(i int) string {
+	return missingTokenBodyPattern(
+		aPendingIPAMJobStatus,
+		aFailedIPAMJobStatus,
+	)[i]
+}
+
+/*
+HTTP response script for TestOperationDelete() API calls
+*/
+func responsesForOperationDelete(i int) (string, int) {
+	return bodyForOperationDelete(i), missingTokenStatusPattern(i)
+}
+
+func bodyForOperationDelete(i int) string {
+	return missingTokenBodyPattern(
+		aPendingIPAMJobStatus,
+		aSuccessfulIPAMJobStatus,
+	)[i]
+}
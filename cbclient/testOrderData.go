@@ -121,3 +121,147 @@ func bodyForGetOrderStatus(i int) string {
 		anOrderStatus,
 	)[i]
 }
+
+const anOrderList string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/orders/?page=1",
+            "title": "List of Orders - Page 1 of 1"
+        }
+    },
+    "total": 1,
+    "count": 1,
+    "_embedded": {
+        "orders": [` + anOrder + `]
+    }
+}`
+
+func responsesForListOrders(i int) (string, int) {
+	return bodyForListOrders(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListOrders(i int) string {
+	return missingTokenBodyPattern(
+		anOrderList,
+	)[i]
+}
+
+const aCanceledOrder string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/orders/ORD-e9v87uia/",
+            "title": "Installation of My Simple Blueprint"
+        },
+        "jobs": []
+    },
+    "name": "Installation of My Simple Blueprint",
+    "id": "ORD-e9v87uia",
+    "status": "CANCELED",
+    "createDate": "2022-04-10T10:04:04.218104"
+}`
+
+const aDuplicatedOrder string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/orders/ORD-k2d8s1xa/",
+            "title": "Installation of My Simple Blueprint"
+        },
+        "jobs": [],
+        "duplicate": {
+            "href": "/api/v3/cmp/orders/ORD-k2d8s1xa/duplicate/",
+            "title": "Duplicate Order"
+        }
+    },
+    "name": "Installation of My Simple Blueprint",
+    "id": "ORD-k2d8s1xa",
+    "status": "PENDING",
+    "createDate": "2022-05-01T08:30:00.000000"
+}`
+
+func responsesForApproveOrder(i int) (string, int) {
+	return bodyForApproveOrder(i), missingTokenStatusPattern(i)
+}
+
+func bodyForApproveOrder(i int) string {
+	return missingTokenBodyPattern(
+		anOrderActive,
+	)[i]
+}
+
+func responsesForDenyOrder(i int) (string, int) {
+	return bodyForDenyOrder(i), missingTokenStatusPattern(i)
+}
+
+func bodyForDenyOrder(i int) string {
+	return missingTokenBodyPattern(
+		anOrderDenied,
+	)[i]
+}
+
+func responsesForCancelOrder(i int) (string, int) {
+	return bodyForCancelOrder(i), missingTokenStatusPattern(i)
+}
+
+func bodyForCancelOrder(i int) string {
+	return missingTokenBodyPattern(
+		aCanceledOrder,
+	)[i]
+}
+
+func responsesForDuplicateOrder(i int) (string, int) {
+	return bodyForDuplicateOrder(i), missingTokenStatusPattern(i)
+}
+
+func bodyForDuplicateOrder(i int) string {
+	return missingTokenBodyPattern(
+		aDuplicatedOrder,
+	)[i]
+}
+
+// The order actions below are accepted, but CloudBolt's response doesn't describe the Order.
+
+func responsesForApproveOrderNoContent(i int) (string, int) {
+	statuses := []int{401, 200, 204, 200}
+	return bodyForApproveOrderNoContent(i), statuses[i]
+}
+
+func bodyForApproveOrderNoContent(i int) string {
+	return missingTokenBodyPattern(
+		"",
+		anOrderActive,
+	)[i]
+}
+
+func responsesForApproveOrderDetail(i int) (string, int) {
+	return bodyForApproveOrderDetail(i), missingTokenStatusPattern(i)
+}
+
+func bodyForApproveOrderDetail(i int) string {
+	return missingTokenBodyPattern(
+		`{"detail": "Order approved"}`,
+		anOrderActive,
+	)[i]
+}
+
+func responsesForCancelOrderUnreadable(i int) (string, int) {
+	statuses := []int{401, 200, 200, 500}
+	return bodyForCancelOrderUnreadable(i), statuses[i]
+}
+
+func bodyForCancelOrderUnreadable(i int) string {
+	return missingTokenBodyPattern(
+		"<html>Order canceled</html>",
+		`{"error": "Internal Server Error"}`,
+	)[i]
+}
+
+func responsesForDuplicateOrderNoContent(i int) (string, int) {
+	statuses := []int{401, 200, 204}
+	return bodyForDuplicateOrderNoContent(i), statuses[i]
+}
+
+func bodyForDuplicateOrderNoContent(i int) string {
+	return missingTokenBodyPattern(
+		"",
+	)[i]
+}
@@ -0,0 +1,117 @@
+package cbclient
+
+const aBlueprintListPage1 string = `{
+    "_links": {
+        "self": {"href": "/api/v3/cmp/blueprints/?page=1&page_size=2", "title": "List of Blueprints - Page 1 of 3"},
+        "next": {"href": "/api/v3/cmp/blueprints/?page=2&page_size=2", "title": "Next Page of Blueprints"}
+    },
+    "total": 5,
+    "count": 2,
+    "_embedded": {
+        "blueprints": [
+            {"_links": {"self": {"href": "/api/v3/cmp/blueprints/BP-00000001/", "title": "Blueprint 1"}}, "name": "Blueprint 1", "id": "BP-00000001"},
+            {"_links": {"self": {"href": "/api/v3/cmp/blueprints/BP-00000002/", "title": "Blueprint 2"}}, "name": "Blueprint 2", "id": "BP-00000002"}
+        ]
+    }
+}`
+
+const aBlueprintListPage2 string = `{
+    "_links": {
+        "self": {"href": "/api/v3/cmp/blueprints/?page=2&page_size=2", "title": "List of Blueprints - Page 2 of 3"},
+        "previous": {"href": "/api/v3/cmp/blueprints/?page=1&page_size=2", "title": "Previous Page of Blueprints"},
+        "next": {"href": "/api/v3/cmp/blueprints/?page=3&page_size=2", "title": "Next Page of Blueprints"}
+    },
+    "total": 5,
+    "count": 2,
+    "_embedded": {
+        "blueprints": [
+            {"_links": {"self": {"href": "/api/v3/cmp/blueprints/BP-00000003/", "title": "Blueprint 3"}}, "name": "Blueprint 3", "id": "BP-00000003"},
+            {"_links": {"self": {"href": "/api/v3/cmp/blueprints/BP-00000004/", "title": "Blueprint 4"}}, "name": "Blueprint 4", "id": "BP-00000004"}
+        ]
+    }
+}`
+
+const aBlueprintListPage3 string = `{
+    "_links": {
+        "self": {"href": "/api/v3/cmp/blueprints/?page=3&page_size=2", "title": "List of Blueprints - Page 3 of 3"},
+        "previous": {"href": "/api/v3/cmp/blueprints/?page=2&page_size=2", "title": "Previous Page of Blueprints"}
+    },
+    "total": 5,
+    "count": 1,
+    "_embedded": {
+        "blueprints": [
+            {"_links": {"self": {"href": "/api/v3/cmp/blueprints/BP-00000005/", "title": "Blueprint 5"}}, "name": "Blueprint 5", "id": "BP-00000005"}
+        ]
+    }
+}`
+
+// The same listing as a server that sends no next links, only the total.
+const aServerListWithoutLinksPage1 string = `{
+    "_links": {
+        "self": {"href": "/api/v3/cmp/servers/?page=1", "title": "List of Servers - Page 1 of 2"}
+    },
+    "total": 3,
+    "count": 2,
+    "_embedded": {
+        "servers": [
+            {"hostname": "server-1", "id": "SVR-00000001"},
+            {"hostname": "server-2", "id": "SVR-00000002"}
+        ]
+    }
+}`
+
+const aServerListWithoutLinksPage2 string = `{
+    "_links": {
+        "self": {"href": "/api/v3/cmp/servers/?page=2", "title": "List of Servers - Page 2 of 2"}
+    },
+    "total": 3,
+    "count": 1,
+    "_embedded": {
+        "servers": [
+            {"hostname": "server-3", "id": "SVR-00000003"}
+        ]
+    }
+}`
+
+/*
+HTTP response script for TestPaginator*() API calls that follow next links
+*/
+func responsesForPaginatedBlueprints(i int) (string, int) {
+	return bodyForPaginatedBlueprints(i), missingTokenStatusPattern(i)
+}
+
+func bodyForPaginatedBlueprints(i int) string {
+	return missingTokenBodyPattern(
+		aBlueprintListPage1,
+		aBlueprintListPage2,
+		aBlueprintListPage3,
+	)[i]
+}
+
+/*
+HTTP response script for TestPaginatorWithoutNextLinks() API calls
+*/
+func responsesForPaginatedServers(i int) (string, int) {
+	return bodyForPaginatedServers(i), missingTokenStatusPattern(i)
+}
+
+func bodyForPaginatedServers(i int) string {
+	return missingTokenBodyPattern(
+		aServerListWithoutLinksPage1,
+		aServerListWithoutLinksPage2,
+	)[i]
+}
+
+/*
+HTTP response script for TestPaginatorError() API calls
+*/
+func responsesForPaginatedBlueprintsError(i int) (string, int) {
+	return bodyForPaginatedBlueprintsError(i), []int{401, 200, 200, 500}[i]
+}
+
+func bodyForPaginatedBlueprintsError(i int) string {
+	return missingTokenBodyPattern(
+		aBlueprintListPage1,
+		`{"detail": "A server error occurred."}`,
+	)[i]
+}
@@ -36,6 +36,28 @@ const aModulePolicyList string = `{
     }
 }`
 
+const aModulePolicy string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/modulePolicies/180/",
+            "title": "My_Module_Policy"
+        },
+        "blueprint": {
+            "href": "/api/v3/onefuse/modules/BP-vjsxfc2z/",
+            "title": "My_Blueprint"
+        },
+        "workspace": {
+            "href": "/api/v3/onefuse/workspaces/2/",
+            "title": "Default"
+        }
+    },
+    "name": "My_Module_Policy",
+    "id": 180,
+    "description": "A Module Policy created through automated tests",
+    "policyTemplate": "{\"provisioningPayload\": {\"deploymentItems\": {\"plugin-bdi-zjn6ztq6\": {\"parameters\": {\"input_text\": \"{{text}}\",\"from\": \"en\",\"to\": \"ja\"}}}}}",
+    "actionPayloads": ""
+}`
+
 const aModuleDeployment string = `{
     "_links": {
       "self": {
@@ -97,3 +119,47 @@ func bodyForGetModuleDeployment(i int) string {
 		aModuleDeployment,
 	)[i]
 }
+
+func responsesForListModulePolicies(i int) (string, int) {
+	return bodyForListModulePolicies(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListModulePolicies(i int) string {
+	return missingTokenBodyPattern(
+		aModulePolicyList,
+	)[i]
+}
+
+const aModuleDeploymentList string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/moduleManagedObjects/?page=1",
+            "title": "List of Module Deployments - Page 1 of 1"
+        }
+    },
+    "total": 1,
+    "count": 1,
+    "_embedded": {
+        "moduleManagedObjects": [` + aModuleDeployment + `]
+    }
+}`
+
+func responsesForListModuleDeployments(i int) (string, int) {
+	return bodyForListModuleDeployments(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListModuleDeployments(i int) string {
+	return missingTokenBodyPattern(
+		aModuleDeploymentList,
+	)[i]
+}
+
+func responsesForSingleModulePolicy(i int) (string, int) {
+	return bodyForSingleModulePolicy(i), missingTokenStatusPattern(i)
+}
+
+func bodyForSingleModulePolicy(i int) string {
+	return missingTokenBodyPattern(
+		aModulePolicy,
+	)[i]
+}
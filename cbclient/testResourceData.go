@@ -3,7 +3,7 @@ package cbclient
 const aResource string = `{
     "_links": {
         "self": {
-            "href": "/api/v3/cmp/resources/RSC-no9aztne/",
+            "href": "/api/v3/cmp/resources/RSC-hjt2wha2/",
             "title": "My Simple Blueprint"
         },
         "resourceType": {
@@ -59,7 +59,7 @@ const aResource string = `{
         ]
     },
     "name": "My Simple Blueprint",
-    "id": "RSC-no9aztne",
+    "id": "RSC-hjt2wha2",
     "created": "2022-04-10 10:04:15",
     "status": "ACTIVE",
     "attributes": [
@@ -216,3 +216,13 @@ func bodyForGetResourceByName(i int) string {
 		aResourceList,
 	)[i]
 }
+
+func responsesForListResources(i int) (string, int) {
+	return bodyForListResources(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListResources(i int) string {
+	return missingTokenBodyPattern(
+		aResourceList,
+	)[i]
+}
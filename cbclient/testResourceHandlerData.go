@@ -58,3 +58,13 @@ func bodyForGetResourceHandlerById(i int) string {
 		aResourceHandler,
 	)[i]
 }
+
+func responsesForListResourceHandlers(i int) (string, int) {
+	return bodyForListResourceHandlers(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListResourceHandlers(i int) string {
+	return missingTokenBodyPattern(
+		aResourceHandlerList,
+	)[i]
+}
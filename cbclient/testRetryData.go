@@ -0,0 +1,64 @@
+package cbclient
+
+import "net/http"
+
+const aServiceUnavailableResponseBody string = `{
+	"detail": "Service temporarily unavailable, try again later."
+}`
+
+const aTooManyRequestsResponseBody string = `{
+	"detail": "Request was throttled."
+}`
+
+/*
+HTTP response script for TestRetryTransientStatus() API calls
+*/
+func responsesForRetryTransientStatus(i int) (string, int) {
+	return bodyForRetryTransientStatus(i), []int{401, 200, 503, 502, 200}[i]
+}
+
+func bodyForRetryTransientStatus(i int) string {
+	return missingTokenBodyPattern(
+		aServiceUnavailableResponseBody,
+		aServiceUnavailableResponseBody,
+		aBlueprint,
+	)[i]
+}
+
+/*
+HTTP response script for TestRetryExhausted() API calls
+*/
+func responsesForRetryExhausted(i int) (string, int) {
+	return bodyForRetryExhausted(i), []int{401, 200, 503, 503, 503}[i]
+}
+
+func bodyForRetryExhausted(i int) string {
+	return missingTokenBodyPattern(
+		aServiceUnavailableResponseBody,
+		aServiceUnavailableResponseBody,
+		aServiceUnavailableResponseBody,
+	)[i]
+}
+
+/*
+HTTP response script for TestRetryAfter() API calls
+*/
+func responsesForRetryAfter(i int) (string, int) {
+	return bodyForRetryAfter(i), []int{401, 200, 429, 200}[i]
+}
+
+func bodyForRetryAfter(i int) string {
+	return missingTokenBodyPattern(
+		aTooManyRequestsResponseBody,
+		aBlueprint,
+	)[i]
+}
+
+// The throttled response asks the client to wait a second before trying again.
+func headersForRetryAfter(i int) http.Header {
+	if i == 2 {
+		return http.Header{"Retry-After": []string{"1"}}
+	}
+
+	return http.Header{}
+}
@@ -41,6 +41,33 @@ const aScriptingPolicyList string = `{
     }
 }`
 
+const aScriptingPolicy string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/scriptingPolicies/901/",
+            "title": "My_Scripting_Policy"
+        },
+        "credential": {
+            "href": "/api/v3/onefuse/moduleCredentials/8328/",
+            "title": "My_Scripting_Policy_Credentials"
+        },
+        "workspace": {
+            "href": "/api/v3/onefuse/workspaces/2/",
+            "title": "Default"
+        }
+    },
+    "name": "My_Scripting_Policy",
+    "id": 901,
+    "description": "A Scripting Policy created through automated tests",
+    "targetHost": "mytest.net",
+    "provisionLaunchCommandTemplate": "sudo /bin/bash {{ scriptName }}",
+    "provisionScript": "echo '{\"provisioning-message\":\"Hello, provisioning Script Template test\"}'\nexit 0",
+    "provisionSuccessExitCodes": "0",
+    "deprovisionScript": "echo '{\"deprovisioning-message\":\"Hello, deprovisioning Script Template test\"}'\nexit 0",
+    "deprovisionLaunchCommandTemplate": "sudo /bin/bash {{ scriptName }}",
+    "deprovisionSuccessExitCodes": "0"
+}`
+
 const aScriptingDeployment string = `{
     "_links": {
       "self": {
@@ -106,3 +133,47 @@ func bodyForScriptingDeployment(i int) string {
 		aScriptingDeployment,
 	)[i]
 }
+
+func responsesForListScriptingPolicies(i int) (string, int) {
+	return bodyForListScriptingPolicies(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListScriptingPolicies(i int) string {
+	return missingTokenBodyPattern(
+		aScriptingPolicyList,
+	)[i]
+}
+
+const aScriptingDeploymentList string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/scriptingDeployments/?page=1",
+            "title": "List of Scripting Deployments - Page 1 of 1"
+        }
+    },
+    "total": 1,
+    "count": 1,
+    "_embedded": {
+        "scriptingDeployments": [` + aScriptingDeployment + `]
+    }
+}`
+
+func responsesForListScriptingDeployments(i int) (string, int) {
+	return bodyForListScriptingDeployments(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListScriptingDeployments(i int) string {
+	return missingTokenBodyPattern(
+		aScriptingDeploymentList,
+	)[i]
+}
+
+func responsesForSingleScriptingPolicy(i int) (string, int) {
+	return bodyForSingleScriptingPolicy(i), missingTokenStatusPattern(i)
+}
+
+func bodyForSingleScriptingPolicy(i int) string {
+	return missingTokenBodyPattern(
+		aScriptingPolicy,
+	)[i]
+}
@@ -318,3 +318,13 @@ func bodyForDecomServerJob(i int) string {
 		aDecomServerJob,
 	)[i]
 }
+
+func responsesForListServers(i int) (string, int) {
+	return bodyForListServers(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListServers(i int) string {
+	return missingTokenBodyPattern(
+		aServerList,
+	)[i]
+}
@@ -37,6 +37,29 @@ const aServiceNowCMDBPolicyList string = `{
     }
 }`
 
+const aServiceNowCMDBPolicy string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/servicenowCMDBPolicies/224/",
+            "title": "My_SNOW_CMDB_Policy"
+        },
+        "workspace": {
+            "href": "/api/v3/onefuse/workspaces/2/",
+            "title": "Default"
+        },
+        "endpoint": {
+            "href": "/api/v3/onefuse/endpoints/7723/",
+            "title": "My_SNOW_CMDB_Policy_Endpoint"
+        }
+    },
+    "name": "My_SNOW_CMDB_Policy",
+    "id": 224,
+    "description": "A ServiceNow CMDB Policy",
+    "provisionTemplate": "{\"items\": [{\"className\": \"cmdb_ci_linux_server\", \"values\": {\"discovery_source\": \"Other Automated\", \"os\": \"GNU/Linux\", \"name\": \"{{ OneFuse_VmNic0.hostname }}-{{OneFuse_Suffix}}\", \"dns_domain\": \"{{ OneFuse_VmNic0.dnsSuffix }}\", \"host_name\": \"{{ OneFuse_VmNic0.hostname }}\", \"fqdn\": \"{{ OneFuse_VmNic0.fqdn }}\", \"ip_address\": \"{{ OneFuse_VmNic0.ipAddress }}\", \"serial_number\": \"vmware-{{ OneFuse_VmHardware.platformUuid }}-{{OneFuse_Suffix}}\", \"cpu_count\": \"{{ OneFuse_VmHardware.cpuCount }}\", \"disk_space\": \"{{ OneFuse_VmHardware.totalStorageGB }}\", \"ram\": \"{{ OneFuse_VmHardware.memoryMB }}\", \"virtual\": \"true\", \"state\": \"{{ OneFuse_VmHardware.powerState }}\", \"hardware_status\": \"installed\"}}]}",
+    "updateTemplate": "{\"items\": [{\"className\": \"cmdb_ci_linux_server\", \"values\": {\"discovery_source\": \"Other Automated\", \"os\": \"GNU/Linux\", \"name\": \"{{ OneFuse_VmNic0.hostname }}-{{OneFuse_Suffix}}\", \"dns_domain\": \"{{ OneFuse_VmNic0.dnsSuffix }}\", \"host_name\": \"{{ OneFuse_VmNic0.hostname }}\", \"fqdn\": \"{{ OneFuse_VmNic0.fqdn }}\", \"ip_address\": \"{{ OneFuse_VmNic0.ipAddress }}\", \"serial_number\": \"vmware-{{ OneFuse_VmHardware.platformUuid }}-{{OneFuse_Suffix}}\", \"cpu_count\": \"{{ OneFuse_VmHardware.cpuCount }}\", \"disk_space\": \"{{ OneFuse_VmHardware.totalStorageGB }}\", \"ram\": \"{{ OneFuse_VmHardware.memoryMB }}\", \"virtual\": \"true\", \"state\": \"{{ OneFuse_VmHardware.powerState }}\", \"hardware_status\": \"installed\"}}]}",
+    "deprovisionTemplate": "{\"items\": [{\"className\": \"cmdb_ci_linux_server\", \"values\": {\"name\": \"{{ OneFuse_VmNic0.hostname }}-{{OneFuse_Suffix}}\", \"discovery_source\": \"Other Automated\", \"serial_number\": \"vmware-{{ OneFuse_VmHardware.platformUuid }}-{{OneFuse_Suffix}}\", \"state\": \"{{ OneFuse_VmHardware.powerState }}\", \"hardware_status\": \"retired\"}}]}"
+}`
+
 const aServiceNowCMDBDeployment string = `{
     "_links": {
       "self": {
@@ -174,3 +197,47 @@ func bodyForGetServiceNowCMDBDeployment(i int) string {
 		aServiceNowCMDBDeployment,
 	)[i]
 }
+
+func responsesForListServiceNowCMDBPolicies(i int) (string, int) {
+	return bodyForListServiceNowCMDBPolicies(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListServiceNowCMDBPolicies(i int) string {
+	return missingTokenBodyPattern(
+		aServiceNowCMDBPolicyList,
+	)[i]
+}
+
+const aServiceNowCMDBDeploymentList string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/servicenowCMDBDeployments/?page=1",
+            "title": "List of ServiceNow CMDB Deployments - Page 1 of 1"
+        }
+    },
+    "total": 1,
+    "count": 1,
+    "_embedded": {
+        "servicenowCMDBDeployments": [` + aServiceNowCMDBDeployment + `]
+    }
+}`
+
+func responsesForListServicenowCMDBDeployments(i int) (string, int) {
+	return bodyForListServicenowCMDBDeployments(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListServicenowCMDBDeployments(i int) string {
+	return missingTokenBodyPattern(
+		aServiceNowCMDBDeploymentList,
+	)[i]
+}
+
+func responsesForSingleServiceNowCMDBPolicy(i int) (string, int) {
+	return bodyForSingleServiceNowCMDBPolicy(i), missingTokenStatusPattern(i)
+}
+
+func bodyForSingleServiceNowCMDBPolicy(i int) string {
+	return missingTokenBodyPattern(
+		aServiceNowCMDBPolicy,
+	)[i]
+}
@@ -43,3 +43,13 @@ func bodyForGetStaticPropertySet(i int) string {
 		aStaticPropertySetList,
 	)[i]
 }
+
+func responsesForListStaticPropertySets(i int) (string, int) {
+	return bodyForListStaticPropertySets(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListStaticPropertySets(i int) string {
+	return missingTokenBodyPattern(
+		aStaticPropertySetList,
+	)[i]
+}
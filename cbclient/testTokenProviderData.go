@@ -0,0 +1,36 @@
+package cbclient
+
+/*
+HTTP response script for token provider tests that never log in with a password
+*/
+func responsesForTokenProvider(i int) (string, int) {
+	return aBlueprint, 200
+}
+
+/*
+HTTP response script for TestTokenProviderLazy() API calls
+*/
+func responsesForTokenProviderLazy(i int) (string, int) {
+	return bodyForTokenProviderLazy(i), missingTokenStatusPattern(i)
+}
+
+func bodyForTokenProviderLazy(i int) string {
+	return []string{
+		anUnauthorizedResponseBody,
+		aBlueprint,
+	}[i]
+}
+
+/*
+HTTP response script for TestPasswordTokenProviderProactive() API calls
+*/
+func responsesForPasswordTokenProviderProactive(i int) (string, int) {
+	return bodyForPasswordTokenProviderProactive(i), 200
+}
+
+func bodyForPasswordTokenProviderProactive(i int) string {
+	return []string{
+		anAuthRequestResponseBody,
+		aBlueprint,
+	}[i]
+}
@@ -41,6 +41,33 @@ const aVraPolicyList string = `{
     }
 }`
 
+const aVraPolicy string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/vraPolicies/1/",
+            "title": "My_Vra_Policy"
+        },
+        "workspace": {
+            "href": "/api/v3/onefuse/workspaces/2/",
+            "title": "Default"
+        },
+        "endpoint": {
+            "href": "/api/v3/onefuse/endpoints/27/",
+            "title": "My_Vra_Policy_Endpoint"
+        }
+    },
+    "id": 1,
+    "type": "vra8",
+    "name": "My_Vra_Policy",
+    "description": "A Vra Policy",
+    "cloudTemplateName": "my_naming_snow_1",
+    "cloudTemplateVersionNumber": "1",
+    "blueprintId": "ce8c92e6-7b00-4a77-971d-8af6a3113359",
+    "projectName": "My Test Project",
+    "cloudTemplateInputs": null,
+    "userMapping": null
+}`
+
 const aVraDeployment string = `{
     "_links": {
       "self": {
@@ -172,3 +199,47 @@ func bodyForGetVraDeployment(i int) string {
 		aVraDeployment,
 	)[i]
 }
+
+func responsesForListVraPolicies(i int) (string, int) {
+	return bodyForListVraPolicies(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListVraPolicies(i int) string {
+	return missingTokenBodyPattern(
+		aVraPolicyList,
+	)[i]
+}
+
+const aVraDeploymentList string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/onefuse/vraDeployments/?page=1",
+            "title": "List of vRA Deployments - Page 1 of 1"
+        }
+    },
+    "total": 1,
+    "count": 1,
+    "_embedded": {
+        "vraDeployments": [` + aVraDeployment + `]
+    }
+}`
+
+func responsesForListVraDeployments(i int) (string, int) {
+	return bodyForListVraDeployments(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListVraDeployments(i int) string {
+	return missingTokenBodyPattern(
+		aVraDeploymentList,
+	)[i]
+}
+
+func responsesForSingleVraPolicy(i int) (string, int) {
+	return bodyForSingleVraPolicy(i), missingTokenStatusPattern(i)
+}
+
+func bodyForSingleVraPolicy(i int) string {
+	return missingTokenBodyPattern(
+		aVraPolicy,
+	)[i]
+}
@@ -0,0 +1,272 @@
+package cbclient
+
+const aRunningJobStarted string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/jobs/JOB-9nrax3gb/",
+            "title": "Deploy Blueprint Job 1011"
+        }
+    },
+    "id": "JOB-9nrax3gb",
+    "type": "deploy_blueprint",
+    "status": "RUNNING",
+    "output": "",
+    "errors": "",
+    "tasksDone": 1,
+    "totalTasks": 3,
+    "progressMessages": [
+        "Provisioning server myawainstance1"
+    ]
+}`
+
+const aRunningJobAlmostDone string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/jobs/JOB-9nrax3gb/",
+            "title": "Deploy Blueprint Job 1011"
+        }
+    },
+    "id": "JOB-9nrax3gb",
+    "type": "deploy_blueprint",
+    "status": "RUNNING",
+    "output": "",
+    "errors": "",
+    "tasksDone": 2,
+    "totalTasks": 3,
+    "progressMessages": [
+        "Provisioning server myawainstance1",
+        "Running My Action on myawainstance1"
+    ]
+}`
+
+const aFailedJob string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/jobs/JOB-9nrax3gb/",
+            "title": "Deploy Blueprint Job 1011"
+        }
+    },
+    "id": "JOB-9nrax3gb",
+    "type": "deploy_blueprint",
+    "status": "FAILURE",
+    "output": "Provisioned myawainstance1",
+    "errors": "My Action failed on myawainstance1",
+    "tasksDone": 2,
+    "totalTasks": 3,
+    "progressMessages": [
+        "Provisioning server myawainstance1",
+        "Running My Action on myawainstance1"
+    ]
+}`
+
+/*
+HTTP response script for TestWaitForJob() API calls
+*/
+func responsesForWaitForJob(i int) (string, int) {
+	return bodyForWaitForJob(i), missingTokenStatusPattern(i)
+}
+
+func bodyForWaitForJob(i int) string {
+	return missingTokenBodyPattern(
+		aRunningJobStarted,
+		aRunningJobStarted,
+		aRunningJobAlmostDone,
+		aJob,
+	)[i]
+}
+
+/*
+HTTP response script for TestWaitForJobFailure() API calls
+*/
+func responsesForWaitForJobFailure(i int) (string, int) {
+	return bodyForWaitForJobFailure(i), missingTokenStatusPattern(i)
+}
+
+func bodyForWaitForJobFailure(i int) string {
+	return missingTokenBodyPattern(
+		aRunningJobStarted,
+		aFailedJob,
+	)[i]
+}
+
+/*
+HTTP response script for TestWaitForJobContextDone() API calls
+*/
+func responsesForWaitForJobContextDone(i int) (string, int) {
+	return bodyForWaitForJobContextDone(i), missingTokenStatusPattern(i)
+}
+
+func bodyForWaitForJobContextDone(i int) string {
+	if i >= 2 {
+		return aRunningJobStarted
+	}
+
+	return missingTokenBodyPattern()[i]
+}
+
+const anOrderPendingApproval string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/orders/ORD-e9v87uia/",
+            "title": "Installation of My Simple Blueprint"
+        },
+        "jobs": []
+    },
+    "name": "Installation of My Simple Blueprint",
+    "id": "ORD-e9v87uia",
+    "status": "PENDING",
+    "createDate": "2022-04-10T10:04:04.218104"
+}`
+
+const anOrderActive string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/orders/ORD-e9v87uia/",
+            "title": "Installation of My Simple Blueprint"
+        },
+        "jobs": [
+            {
+                "href": "/api/v3/cmp/jobs/JOB-9nrax3gb/",
+                "title": "Deploy Blueprint Job 1011"
+            }
+        ]
+    },
+    "name": "Installation of My Simple Blueprint",
+    "id": "ORD-e9v87uia",
+    "status": "ACTIVE",
+    "createDate": "2022-04-10T10:04:04.218104",
+    "approveDate": "2022-04-10T10:04:15.041024"
+}`
+
+const anOrderSucceeded string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/orders/ORD-e9v87uia/",
+            "title": "Installation of My Simple Blueprint"
+        },
+        "jobs": [
+            {
+                "href": "/api/v3/cmp/jobs/JOB-9nrax3gb/",
+                "title": "Deploy Blueprint Job 1011"
+            }
+        ]
+    },
+    "name": "Installation of My Simple Blueprint",
+    "id": "ORD-e9v87uia",
+    "status": "SUCCESS",
+    "createDate": "2022-04-10T10:04:04.218104",
+    "approveDate": "2022-04-10T10:04:15.041024"
+}`
+
+const anOrderFailed string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/orders/ORD-e9v87uia/",
+            "title": "Installation of My Simple Blueprint"
+        },
+        "jobs": [
+            {
+                "href": "/api/v3/cmp/jobs/JOB-9nrax3gb/",
+                "title": "Deploy Blueprint Job 1011"
+            }
+        ]
+    },
+    "name": "Installation of My Simple Blueprint",
+    "id": "ORD-e9v87uia",
+    "status": "FAILURE",
+    "createDate": "2022-04-10T10:04:04.218104",
+    "approveDate": "2022-04-10T10:04:15.041024"
+}`
+
+const anOrderDenied string = `{
+    "_links": {
+        "self": {
+            "href": "/api/v3/cmp/orders/ORD-e9v87uia/",
+            "title": "Installation of My Simple Blueprint"
+        },
+        "jobs": []
+    },
+    "name": "Installation of My Simple Blueprint",
+    "id": "ORD-e9v87uia",
+    "status": "DENIED",
+    "createDate": "2022-04-10T10:04:04.218104"
+}`
+
+const aSucceededOrderStatus string = `{
+    "status": "SUCCESS",
+    "outputMessages": [
+        "Job 1011: Blueprint deployed successfully"
+    ],
+    "errorMessages": [],
+    "progressMessages": [
+        "Job 1011: Provisioning server myawainstance1",
+        "Job 1011: Running My Action on myawainstance1"
+    ]
+}`
+
+const aFailedOrderStatus string = `{
+    "status": "FAILURE",
+    "outputMessages": [
+        "Job 1011: Provisioned myawainstance1"
+    ],
+    "errorMessages": [
+        "Job 1011: My Action failed on myawainstance1"
+    ]
+}`
+
+const aDeniedOrderStatus string = `{
+    "status": "DENIED",
+    "outputMessages": [],
+    "errorMessages": []
+}`
+
+/*
+HTTP response script for TestWaitForOrder() API calls
+*/
+func responsesForWaitForOrder(i int) (string, int) {
+	return bodyForWaitForOrder(i), missingTokenStatusPattern(i)
+}
+
+func bodyForWaitForOrder(i int) string {
+	return missingTokenBodyPattern(
+		anOrderPendingApproval,
+		anOrderActive,
+		aRunningJobStarted,
+		aJob,
+		anOrderSucceeded,
+		aSucceededOrderStatus,
+		aResource,
+		aServer,
+	)[i]
+}
+
+/*
+HTTP response script for TestWaitForOrderFailure() API calls
+*/
+func responsesForWaitForOrderFailure(i int) (string, int) {
+	return bodyForWaitForOrderFailure(i), missingTokenStatusPattern(i)
+}
+
+func bodyForWaitForOrderFailure(i int) string {
+	return missingTokenBodyPattern(
+		anOrderActive,
+		aFailedJob,
+		anOrderFailed,
+		aFailedOrderStatus,
+	)[i]
+}
+
+/*
+HTTP response script for TestWaitForOrderDenied() API calls
+*/
+func responsesForWaitForOrderDenied(i int) (string, int) {
+	return bodyForWaitForOrderDenied(i), missingTokenStatusPattern(i)
+}
+
+func bodyForWaitForOrderDenied(i int) string {
+	return missingTokenBodyPattern(
+		anOrderPendingApproval,
+		anOrderDenied,
+		aDeniedOrderStatus,
+	)[i]
+}
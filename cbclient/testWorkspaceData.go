@@ -34,3 +34,13 @@ func bodyForGetWorkspace(i int) string {
 		aWorkspaceList,
 	)[i]
 }
+
+func responsesForListWorkSpaces(i int) (string, int) {
+	return bodyForListWorkSpaces(i), missingTokenStatusPattern(i)
+}
+
+func bodyForListWorkSpaces(i int) string {
+	return missingTokenBodyPattern(
+		aWorkspaceList,
+	)[i]
+}
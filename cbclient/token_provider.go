@@ -0,0 +1,183 @@
+package cbclient
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// errNoToken is returned when a TokenProvider returns neither a token nor an error.
+var errNoToken = errors.New("TokenProvider returned no token")
+
+// Token is a CloudBolt API token.
+// Expiry is when the token stops being accepted; the zero value means it isn't known.
+type Token struct {
+	Value  string
+	Expiry time.Time
+}
+
+// TokenProvider supplies the API tokens a CloudBoltClient sends with its requests.
+// Token is called whenever the client needs a new token: after a request was
+// rejected for authentication, or ahead of expiry when SetTokenRefresh is used.
+//
+// Token may be called from several goroutines, but never more than once at a time per client.
+type TokenProvider interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenProviderFunc lets an ordinary function be used as a TokenProvider.
+type TokenProviderFunc func(ctx context.Context) (*Token, error)
+
+// Token calls f(ctx).
+func (f TokenProviderFunc) Token(ctx context.Context) (*Token, error) {
+	return f(ctx)
+}
+
+// passwordTokenProvider logs in with a username and password at /api/v3/cmp/apiToken/.
+// This is what New sets up.
+type passwordTokenProvider struct {
+	client *CloudBoltClient
+}
+
+// staticTokenProvider always returns the same pre-issued token.
+type staticTokenProvider struct {
+	token string
+}
+
+// fileTokenProvider reads the token from a file every time one is needed.
+type fileTokenProvider struct {
+	path string
+}
+
+// envTokenProvider reads the token from an environment variable every time one is needed.
+type envTokenProvider struct {
+	name string
+}
+
+// StaticToken returns a TokenProvider for a pre-issued API token.
+// If the token is a JWT, its expiry is read from the "exp" claim.
+func StaticToken(token string) TokenProvider {
+	return &staticTokenProvider{token: token}
+}
+
+// TokenFromFile returns a TokenProvider that reads the API token from the file at path.
+// The file is read again every time a token is needed, so a token rotated on disk is picked up.
+// Surrounding whitespace is ignored.
+func TokenFromFile(path string) TokenProvider {
+	return &fileTokenProvider{path: path}
+}
+
+// TokenFromEnv returns a TokenProvider that reads the API token from the environment variable name.
+// The variable is read again every time a token is needed.
+func TokenFromEnv(name string) TokenProvider {
+	return &envTokenProvider{name: name}
+}
+
+// SetCredentials makes the CloudBoltClient log in with a username and password,
+// replacing any TokenProvider set before. This is what New does.
+// The current token is kept until it is rejected or expires.
+func (c *CloudBoltClient) SetCredentials(username string, password string, domain string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	c.username = username
+	c.password = password
+	c.domain = domain
+	c.tokenProvider = &passwordTokenProvider{client: c}
+}
+
+// SetTokenProvider sets where the CloudBoltClient gets its API tokens from.
+// The current token is dropped, so the next request uses one from provider.
+func (c *CloudBoltClient) SetTokenProvider(provider TokenProvider) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	c.tokenProvider = provider
+	c.token = ""
+	c.tokenExpiry = time.Time{}
+}
+
+// SetTokenRefresh turns on proactive token refresh.
+// The CloudBoltClient then fetches a token before its first request, instead of waiting
+// to be rejected, and fetches a new one once the current token is within window of its Expiry.
+// Tokens without a known Expiry are only replaced when a request is rejected.
+//
+// Proactive refresh is off by default.
+func (c *CloudBoltClient) SetTokenRefresh(window time.Duration) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	c.proactiveRefresh = true
+	c.refreshWindow = window
+}
+
+func (p *passwordTokenProvider) Token(ctx context.Context) (*Token, error) {
+	_, token, err := p.client.requestToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Token{Value: token, Expiry: jwtExpiry(token)}, nil
+}
+
+func (p *staticTokenProvider) Token(ctx context.Context) (*Token, error) {
+	return newToken(p.token, "static token")
+}
+
+func (p *fileTokenProvider) Token(ctx context.Context) (*Token, error) {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("reading API token: %w", err)
+	}
+
+	return newToken(string(data), p.path)
+}
+
+func (p *envTokenProvider) Token(ctx context.Context) (*Token, error) {
+	return newToken(os.Getenv(p.name), "$"+p.name)
+}
+
+// newToken trims value and wraps it in a Token with the expiry of the JWT, if it is one.
+// source names where the token came from for the error message.
+func newToken(value string, source string) (*Token, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, fmt.Errorf("no API token in %s", source)
+	}
+
+	return &Token{Value: value, Expiry: jwtExpiry(value)}, nil
+}
+
+// jwtExpiry returns the time in the "exp" claim of a JWT,
+// or the zero time if token isn't a JWT or has no "exp".
+// The signature is not checked; CloudBolt does that.
+func jwtExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		Exp json.Number `json:"exp"`
+	}
+	if json.Unmarshal(payload, &claims) != nil {
+		return time.Time{}
+	}
+
+	exp, err := claims.Exp.Float64()
+	if err != nil || exp <= 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(exp), 0)
+}
@@ -0,0 +1,226 @@
+package cbclient
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// testJWT builds an unsigned JWT that expires at exp.
+func testJWT(exp time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"user_id":1,"exp":%d}`, exp.Unix())))
+
+	return fmt.Sprintf("%s.%s.signature", header, claims)
+}
+
+func TestStaticToken(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForTokenProvider)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.SetTokenProvider(StaticToken("Static Token"))
+	client.SetTokenRefresh(0)
+
+	blueprint, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprint).NotTo(BeNil())
+
+	// The token was there before the first request, with no password login
+	Expect(len(*requests)).To(Equal(1))
+	Expect((*requests)[0].URL.Path).To(Equal("/api/v3/cmp/blueprints/BP-esnjtp7u/"))
+	Expect((*requests)[0].Header.Get("Authorization")).To(Equal("Bearer Static Token"))
+}
+
+func TestTokenProviderLazy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForTokenProviderLazy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.SetTokenProvider(StaticToken("Static Token"))
+
+	blueprint, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprint).NotTo(BeNil())
+
+	// Without proactive refresh the token is only fetched after a 401,
+	// but it still never goes to /apiToken/
+	Expect(len(*requests)).To(Equal(2))
+	Expect((*requests)[0].Header.Get("Authorization")).To(Equal("Bearer"))
+	Expect((*requests)[1].Header.Get("Authorization")).To(Equal("Bearer Static Token"))
+}
+
+func TestTokenFromFile(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForTokenProvider)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	Expect(os.WriteFile(tokenFile, []byte("File Token\n"), 0600)).To(Succeed())
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.SetTokenProvider(TokenFromFile(tokenFile))
+	client.SetTokenRefresh(0)
+
+	_, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect((*requests)[0].Header.Get("Authorization")).To(Equal("Bearer File Token"))
+
+	// A rotated token is read on the next authentication
+	Expect(os.WriteFile(tokenFile, []byte("Rotated Token"), 0600)).To(Succeed())
+	_, err = client.Authenticate()
+	Expect(err).NotTo(HaveOccurred())
+
+	_, err = client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect((*requests)[1].Header.Get("Authorization")).To(Equal("Bearer Rotated Token"))
+
+	// A missing file is an error, not an empty token
+	client.SetTokenProvider(TokenFromFile(filepath.Join(t.TempDir(), "missing")))
+	_, err = client.GetBlueprintById("BP-esnjtp7u")
+	Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+	Expect(len(*requests)).To(Equal(2))
+}
+
+func TestTokenFromEnv(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForTokenProvider)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	t.Setenv("CBCLIENT_TEST_TOKEN", "Env Token")
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.SetTokenProvider(TokenFromEnv("CBCLIENT_TEST_TOKEN"))
+	client.SetTokenRefresh(0)
+
+	_, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect((*requests)[0].Header.Get("Authorization")).To(Equal("Bearer Env Token"))
+
+	// An unset variable is an error
+	client.SetTokenProvider(TokenFromEnv("CBCLIENT_TEST_UNSET_TOKEN"))
+	_, err = client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).To(MatchError("no API token in $CBCLIENT_TEST_UNSET_TOKEN"))
+	Expect(len(*requests)).To(Equal(1))
+}
+
+func TestTokenProviderFunc(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForTokenProvider)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// Hand out a token that is about to expire, then one that lasts
+	tokens := []*Token{
+		{Value: "Short Token", Expiry: time.Now().Add(50 * time.Millisecond)},
+		{Value: testJWT(time.Now().Add(time.Hour))},
+	}
+	calls := 0
+	client.SetTokenProvider(TokenProviderFunc(func(ctx context.Context) (*Token, error) {
+		token := tokens[calls]
+		calls++
+		return token, nil
+	}))
+	client.SetTokenRefresh(10 * time.Millisecond)
+
+	_, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(calls).To(Equal(1))
+	Expect((*requests)[0].Header.Get("Authorization")).To(Equal("Bearer Short Token"))
+
+	// Once inside the refresh window the token is replaced before the request goes out
+	time.Sleep(50 * time.Millisecond)
+	_, err = client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(calls).To(Equal(2))
+	Expect((*requests)[1].Header.Get("Authorization")).To(Equal("Bearer " + tokens[1].Value))
+
+	// The JWT's expiry is an hour away, so it is reused
+	_, err = client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(calls).To(Equal(2))
+	Expect(len(*requests)).To(Equal(3))
+
+	// Errors from the provider come back to the caller
+	providerErr := errors.New("vault is sealed")
+	client.SetTokenProvider(TokenProviderFunc(func(ctx context.Context) (*Token, error) {
+		return nil, providerErr
+	}))
+	_, err = client.GetBlueprintById("BP-esnjtp7u")
+	Expect(errors.Is(err, providerErr)).To(BeTrue())
+	Expect(len(*requests)).To(Equal(3))
+
+	status, err := client.Authenticate()
+	Expect(status).To(Equal(-1))
+	Expect(errors.Is(err, providerErr)).To(BeTrue())
+}
+
+func TestPasswordTokenProviderProactive(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForPasswordTokenProviderProactive)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+	client.SetTokenRefresh(time.Minute)
+
+	blueprint, err := client.GetBlueprintById("BP-esnjtp7u")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blueprint).NotTo(BeNil())
+
+	// The login happens up front instead of after a 401
+	Expect(len(*requests)).To(Equal(2))
+	Expect((*requests)[0].URL.Path).To(Equal("/api/v3/cmp/apiToken/"))
+	Expect((*requests)[1].Header.Get("Authorization")).To(Equal("Bearer Testing Token"))
+}
+
+func TestJWTExpiry(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	exp := time.Unix(1893456000, 0)
+	Expect(jwtExpiry(testJWT(exp))).To(Equal(exp))
+
+	Expect(jwtExpiry("Testing Token").IsZero()).To(BeTrue())
+	Expect(jwtExpiry("a.b.c").IsZero()).To(BeTrue())
+	Expect(jwtExpiry("a." + base64.RawURLEncoding.EncodeToString([]byte(`{"user_id":1}`)) + ".c").IsZero()).To(BeTrue())
+}
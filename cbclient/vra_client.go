@@ -1,11 +1,10 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"net/url"
 	"strconv"
 )
 
@@ -16,15 +15,43 @@ type VraPolicyResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the VraPolicies on this page of results.
+func (r *VraPolicyResult) Items() []VraPolicy {
+	return r.Embedded.VraPolicies
+}
+
+type VraDeploymentResult struct {
+	CloudBoltResult
+	Embedded struct {
+		VraDeployments []VraDeployment `json:"vraDeployments"`
+	} `json:"_embedded"`
+}
+
+// Items returns the vRA Deployments on this page of results.
+func (r *VraDeploymentResult) Items() []VraDeployment {
+	return r.Embedded.VraDeployments
+}
+
 type VraPolicy struct {
 	Links *struct {
 		Self      CloudBoltHALItem `json:"self,omitempty"`
 		Workspace CloudBoltHALItem `json:"workspace,omitempty"`
 		Endpoint  CloudBoltHALItem `json:"endpoint,omitempty"`
 	} `json:"_links,omitempty"`
-	ID          int    `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	WorkspaceURL string `json:"workspace,omitempty"`
+	// Endpoint is the URL of the vRA endpoint, e.g., "/api/v3/onefuse/endpoints/27/"
+	Endpoint string `json:"endpoint,omitempty"`
+	// Type is the vRA version, e.g., "vra8"
+	Type                       string      `json:"type,omitempty"`
+	ProjectName                string      `json:"projectName,omitempty"`
+	CloudTemplateName          string      `json:"cloudTemplateName,omitempty"`
+	CloudTemplateVersionNumber string      `json:"cloudTemplateVersionNumber,omitempty"`
+	BlueprintID                string      `json:"blueprintId,omitempty"`
+	CloudTemplateInputs        interface{} `json:"cloudTemplateInputs,omitempty"`
+	UserMapping                interface{} `json:"userMapping,omitempty"`
 }
 
 type VraDeployment struct {
@@ -42,22 +69,37 @@ type VraDeployment struct {
 	Name               string                 `json:"name,omitempty"`
 	Archived           bool                   `json:"archived,omitempty"`
 	TemplateProperties map[string]interface{} `json:"templateProperties"`
-	DeploymentInfo     map[string]interface{} `json:"deploymentInfo,omitempty"`
-	BlueprintName      string                 `json:"blueprintName,omitempty"`
-	ProjectName        string                 `json:"projectName,omitempty"`
+	// DeploymentInfo holds one object per vRA deployment.
+	DeploymentInfo []map[string]interface{} `json:"deploymentInfo,omitempty"`
+	BlueprintName  string                   `json:"blueprintName,omitempty"`
+	ProjectName    string                   `json:"projectName,omitempty"`
 }
 
-func (c *CloudBoltClient) GetVraPolicy(name string) (*VraPolicy, error) {
+func (c *CloudBoltClient) GetVraPolicy(name string, filters ...*Filter) (*VraPolicy, error) {
+	return c.GetVraPolicyWithContext(context.Background(), name, filters...)
+}
+
+// GetVraPolicyWithContext is the same as GetVraPolicy with a caller-provided context.
+func (c *CloudBoltClient) GetVraPolicyWithContext(ctx context.Context, name string, filters ...*Filter) (*VraPolicy, error) {
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
+
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "vraPolicies")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res VraPolicyResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -74,10 +116,15 @@ func (c *CloudBoltClient) GetVraPolicy(name string) (*VraPolicy, error) {
 }
 
 func (c *CloudBoltClient) CreateVraDeployment(vraDeployment *VraDeployment) (*OneFuseJobStatus, error) {
-	log.Println("onefuse.apiClient: CreateVraDeployment")
+	return c.CreateVraDeploymentWithContext(context.Background(), vraDeployment)
+}
+
+// CreateVraDeploymentWithContext is the same as CreateVraDeployment with a caller-provided context.
+func (c *CloudBoltClient) CreateVraDeploymentWithContext(ctx context.Context, vraDeployment *VraDeployment) (*OneFuseJobStatus, error) {
+	c.log().Debug("onefuse.apiClient: CreateVraDeployment")
 
 	if vraDeployment.WorkspaceURL == "" {
-		workspace, err := c.GetDefaultWorkSpace()
+		workspace, err := c.GetDefaultWorkSpaceWithContext(ctx)
 
 		if err != nil {
 			return nil, err
@@ -104,10 +151,11 @@ func (c *CloudBoltClient) CreateVraDeployment(vraDeployment *VraDeployment) (*On
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "vraDeployments")
 
-	resp, err := c.makeRequest("POST", apiurl.String(), reqJSON)
+	resp, err := c.makeRequest(ctx, "POST", apiurl.String(), reqJSON)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -119,13 +167,22 @@ func (c *CloudBoltClient) CreateVraDeployment(vraDeployment *VraDeployment) (*On
 }
 
 func (c *CloudBoltClient) GetVraDeployment(vraDeploymentPath string) (*VraDeployment, error) {
+	return c.GetVraDeploymentWithContext(context.Background(), vraDeploymentPath)
+}
+
+// GetVraDeploymentWithContext is the same as GetVraDeployment with a caller-provided context.
+func (c *CloudBoltClient) GetVraDeploymentWithContext(ctx context.Context, vraDeploymentPath string) (*VraDeployment, error) {
 	apiurl := c.baseURL
 	apiurl.Path = vraDeploymentPath
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -137,13 +194,22 @@ func (c *CloudBoltClient) GetVraDeployment(vraDeploymentPath string) (*VraDeploy
 }
 
 func (c *CloudBoltClient) GetVraDeploymentById(vraDeploymentId string) (*VraDeployment, error) {
+	return c.GetVraDeploymentByIdWithContext(context.Background(), vraDeploymentId)
+}
+
+// GetVraDeploymentByIdWithContext is the same as GetVraDeploymentById with a caller-provided context.
+func (c *CloudBoltClient) GetVraDeploymentByIdWithContext(ctx context.Context, vraDeploymentId string) (*VraDeployment, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "vraDeployments", vraDeploymentId)
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	err = checkHttpStatus(resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -155,13 +221,19 @@ func (c *CloudBoltClient) GetVraDeploymentById(vraDeploymentId string) (*VraDepl
 }
 
 func (c *CloudBoltClient) DeleteVraDeployment(vraDeploymentId string) (*OneFuseJobStatus, error) {
+	return c.DeleteVraDeploymentWithContext(context.Background(), vraDeploymentId)
+}
+
+// DeleteVraDeploymentWithContext is the same as DeleteVraDeployment with a caller-provided context.
+func (c *CloudBoltClient) DeleteVraDeploymentWithContext(ctx context.Context, vraDeploymentId string) (*OneFuseJobStatus, error) {
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "vraDeployments", vraDeploymentId)
 
-	resp, err := c.makeRequest("DELETE", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "DELETE", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	// Handle some common HTTP errors
 	job_status, err := checkOneFuseResponse(resp)
@@ -171,3 +243,84 @@ func (c *CloudBoltClient) DeleteVraDeployment(vraDeploymentId string) (*OneFuseJ
 
 	return job_status, nil
 }
+
+// ListVraPolicies fetches every vRA Policy the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListVraPolicies(opts *ListOptions) ([]VraPolicy, error) {
+	return c.ListVraPoliciesWithContext(context.Background(), opts)
+}
+
+// ListVraPoliciesWithContext is the same as ListVraPolicies with a caller-provided context.
+func (c *CloudBoltClient) ListVraPoliciesWithContext(ctx context.Context, opts *ListOptions) ([]VraPolicy, error) {
+	return NewPaginator[VraPolicy, VraPolicyResult](c, c.apiEndpoint("onefuse", "vraPolicies"), opts).ListAll(ctx)
+}
+
+// ListVraDeployments fetches every vRA Deployment the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., NewFilter().Policy(2).Archived(false).
+func (c *CloudBoltClient) ListVraDeployments(opts *ListOptions) ([]VraDeployment, error) {
+	return c.ListVraDeploymentsWithContext(context.Background(), opts)
+}
+
+// ListVraDeploymentsWithContext is the same as ListVraDeployments with a caller-provided context.
+func (c *CloudBoltClient) ListVraDeploymentsWithContext(ctx context.Context, opts *ListOptions) ([]VraDeployment, error) {
+	return NewPaginator[VraDeployment, VraDeploymentResult](c, c.apiEndpoint("onefuse", "vraDeployments"), opts).ListAll(ctx)
+}
+
+// CreateVraPolicy creates a vRA Policy in OneFuse and returns it as saved.
+// If newPolicy has no WorkspaceURL it goes in the default workspace.
+func (c *CloudBoltClient) CreateVraPolicy(newPolicy *VraPolicy) (*VraPolicy, error) {
+	return c.CreateVraPolicyWithContext(context.Background(), newPolicy)
+}
+
+// CreateVraPolicyWithContext is the same as CreateVraPolicy with a caller-provided context.
+func (c *CloudBoltClient) CreateVraPolicyWithContext(ctx context.Context, newPolicy *VraPolicy) (*VraPolicy, error) {
+	var policy VraPolicy
+	err := c.createPolicy(ctx, "vraPolicies", &newPolicy.WorkspaceURL, newPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// GetVraPolicyByID fetches the vRA Policy with the given ID.
+func (c *CloudBoltClient) GetVraPolicyByID(policyId string) (*VraPolicy, error) {
+	return c.GetVraPolicyByIDWithContext(context.Background(), policyId)
+}
+
+// GetVraPolicyByIDWithContext is the same as GetVraPolicyByID with a caller-provided context.
+func (c *CloudBoltClient) GetVraPolicyByIDWithContext(ctx context.Context, policyId string) (*VraPolicy, error) {
+	var policy VraPolicy
+	err := c.getPolicy(ctx, "vraPolicies", policyId, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// UpdateVraPolicy replaces the vRA Policy with the given ID and returns it as saved.
+func (c *CloudBoltClient) UpdateVraPolicy(policyId string, updatedPolicy *VraPolicy) (*VraPolicy, error) {
+	return c.UpdateVraPolicyWithContext(context.Background(), policyId, updatedPolicy)
+}
+
+// UpdateVraPolicyWithContext is the same as UpdateVraPolicy with a caller-provided context.
+func (c *CloudBoltClient) UpdateVraPolicyWithContext(ctx context.Context, policyId string, updatedPolicy *VraPolicy) (*VraPolicy, error) {
+	var policy VraPolicy
+	err := c.updatePolicy(ctx, "vraPolicies", policyId, updatedPolicy, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// DeleteVraPolicy deletes the vRA Policy with the given ID.
+func (c *CloudBoltClient) DeleteVraPolicy(policyId string) error {
+	return c.DeleteVraPolicyWithContext(context.Background(), policyId)
+}
+
+// DeleteVraPolicyWithContext is the same as DeleteVraPolicy with a caller-provided context.
+func (c *CloudBoltClient) DeleteVraPolicyWithContext(ctx context.Context, policyId string) error {
+	return c.deletePolicy(ctx, "vraPolicies", policyId)
+}
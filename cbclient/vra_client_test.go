@@ -1,6 +1,7 @@
 package cbclient
 
 import (
+	"encoding/json"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -196,3 +197,224 @@ func TestDeleteVraDeployment(t *testing.T) {
 	// The CloudBolt Order object should be parsed correctly
 	verifyJobStatus(jobStatus)
 }
+
+func TestListVraPolicies(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListVraPolicies)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	policies, err := client.ListVraPolicies(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the vRA Policies, get a token
+	// 3. Successfully getting the only page of vRA Policies
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/vraPolicies/"))
+
+	Expect(policies).To(HaveLen(1))
+	Expect(policies[0].ID).To(Equal(1))
+}
+
+func TestListVraDeployments(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListVraDeployments)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	deployments, err := client.ListVraDeployments(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the vRA Deployments, get a token
+	// 3. Successfully getting the only page of vRA Deployments
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/vraDeployments/"))
+
+	Expect(deployments).To(HaveLen(1))
+	Expect(deployments[0].ID).To(Equal(1))
+	Expect(deployments[0].DeploymentInfo).To(HaveLen(1))
+}
+
+func TestCreateVraPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleVraPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	newPolicy := VraPolicy{
+		Name:                       "My_Vra_Policy",
+		Description:                "A Vra Policy",
+		WorkspaceURL:               "/api/v3/onefuse/workspaces/2/",
+		Endpoint:                   "/api/v3/onefuse/endpoints/27/",
+		ProjectName:                "My Test Project",
+		CloudTemplateName:          "my_naming_snow_1",
+		CloudTemplateVersionNumber: "1",
+	}
+
+	policy, err := client.CreateVraPolicy(&newPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to create, get a token
+	// 3. Successful create
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("POST"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/vraPolicies/"))
+
+	verifyVraPolicy(policy)
+}
+
+func TestGetVraPolicyByID(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleVraPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	policy, err := client.GetVraPolicyByID("1")
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get, get a token
+	// 3. Successful Get
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/vraPolicies/1/"))
+
+	verifyVraPolicy(policy)
+}
+
+func TestUpdateVraPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleVraPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	updatedPolicy := VraPolicy{
+		Name:                       "My_Vra_Policy",
+		Description:                "A Vra Policy",
+		WorkspaceURL:               "/api/v3/onefuse/workspaces/2/",
+		Endpoint:                   "/api/v3/onefuse/endpoints/27/",
+		ProjectName:                "My Test Project",
+		CloudTemplateName:          "my_naming_snow_1",
+		CloudTemplateVersionNumber: "1",
+	}
+
+	policy, err := client.UpdateVraPolicy("1", &updatedPolicy)
+	Expect(policy).NotTo(BeNil())
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to update, get a token
+	// 3. Successful update
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("PUT"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/vraPolicies/1/"))
+
+	verifyVraPolicy(policy)
+}
+
+func TestDeleteVraPolicy(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForSingleVraPolicy)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	err := client.DeleteVraPolicy("1")
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to delete, get a token
+	// 3. Successful delete
+	Expect(len(*requests)).To(Equal(3))
+
+	// The last request is the one we care about
+	Expect((*requests)[2].Method).To(Equal("DELETE"))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/vraPolicies/1/"))
+}
+
+func verifyVraPolicy(policy *VraPolicy) {
+	Expect(policy.Links.Self.Href).To(Equal("/api/v3/onefuse/vraPolicies/1/"))
+	Expect(policy.Links.Self.Title).To(Equal("My_Vra_Policy"))
+	Expect(policy.Links.Workspace.Href).To(Equal("/api/v3/onefuse/workspaces/2/"))
+	Expect(policy.ID).To(Equal(1))
+	Expect(policy.Name).To(Equal("My_Vra_Policy"))
+	Expect(policy.Type).To(Equal("vra8"))
+	Expect(policy.CloudTemplateName).To(Equal("my_naming_snow_1"))
+	Expect(policy.CloudTemplateVersionNumber).To(Equal("1"))
+	Expect(policy.ProjectName).To(Equal("My Test Project"))
+	Expect(policy.CloudTemplateInputs).To(BeNil())
+}
+
+func TestVraDeploymentInfo(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// The payload OneFuse sends decodes without errors.
+	// DeploymentInfo used to be a map, which failed on the list in it.
+	var deployment VraDeployment
+	Expect(json.Unmarshal([]byte(aVraDeployment), &deployment)).To(Succeed())
+
+	Expect(deployment.DeploymentInfo).To(HaveLen(1))
+	Expect(deployment.DeploymentInfo[0]).To(HaveKeyWithValue("name", "Deployment Name"))
+	Expect(deployment.DeploymentInfo[0]).To(HaveKeyWithValue("status", "CREATE_SUCCESSFUL"))
+	Expect(deployment.DeploymentInfo[0]["childResources"]).To(HaveLen(1))
+}
@@ -0,0 +1,272 @@
+package cbclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WaitOptions controls how WaitForJob polls CloudBolt.
+// The zero value polls every 5 seconds without backing off.
+type WaitOptions struct {
+	// Interval is the delay between polls. Defaults to 5 seconds.
+	Interval time.Duration
+	// MaxInterval caps the delay when Multiplier is more than 1. Zero means no cap.
+	MaxInterval time.Duration
+	// Multiplier is applied to the delay after every poll. Values under 1 mean a fixed Interval.
+	Multiplier float64
+	// Progress, if set, is called after every poll that finds the job has moved on:
+	// the first poll, a change in TasksDone or TotalTasks, or new ProgressMessages.
+	Progress func(JobProgress)
+}
+
+// JobProgress is what WaitOptions.Progress is told about a job.
+type JobProgress struct {
+	Job        *CloudBoltJob
+	TasksDone  int
+	TotalTasks int
+	// NewMessages are the ProgressMessages added since the previous call.
+	NewMessages []string
+}
+
+// JobError is returned by WaitForJob when a job ends with FAILURE or CANCELED.
+type JobError struct {
+	JobPath string
+	Status  JobStatus
+	Errors  string
+	Output  string
+	Job     *CloudBoltJob
+}
+
+// OrderResult is what WaitForOrder found once the order finished.
+type OrderResult struct {
+	Order *CloudBoltOrder
+	// Jobs are the order's jobs, as they were when they finished.
+	Jobs []*CloudBoltJob
+	// OutputMessages, ErrorMessages and ProgressMessages are collected from all the order's jobs.
+	OutputMessages   []string
+	ErrorMessages    []string
+	ProgressMessages []string
+	// Resources and Servers are the ones the order's jobs created or acted on.
+	Resources []*CloudBoltResource
+	Servers   []*CloudBoltServer
+}
+
+// OrderError is returned by WaitForOrder when an order ends with FAILURE, DENIED or CANCELED.
+type OrderError struct {
+	OrderID       string
+	Status        OrderStatus
+	ErrorMessages []string
+	// JobErrors are the errors of the order's jobs that failed or were canceled.
+	JobErrors []*JobError
+}
+
+// defaultWaitInterval is the poll interval used when WaitOptions doesn't set one.
+const defaultWaitInterval time.Duration = 5 * time.Second
+
+func (e *JobError) Error() string {
+	if e.Errors != "" {
+		return fmt.Sprintf("job %s finished with status %s: %s", e.JobPath, e.Status, e.Errors)
+	}
+
+	return fmt.Sprintf("job %s finished with status %s", e.JobPath, e.Status)
+}
+
+func (e *OrderError) Error() string {
+	if len(e.ErrorMessages) > 0 {
+		return fmt.Sprintf("order %s finished with status %s: %s", e.OrderID, e.Status, strings.Join(e.ErrorMessages, "; "))
+	}
+
+	return fmt.Sprintf("order %s finished with status %s", e.OrderID, e.Status)
+}
+
+// WaitForJob polls the Job at jobPath until it reaches SUCCESS, WARNING, FAILURE or CANCELED,
+// and returns it. A job that ends with FAILURE or CANCELED is returned along with a *JobError.
+// opts may be nil.
+//
+// If ctx is done first, the last Job fetched is returned with ctx's error.
+// Errors from fetching the Job end the wait; set a RetryPolicy to ride out transient ones.
+func (c *CloudBoltClient) WaitForJob(ctx context.Context, jobPath string, opts *WaitOptions) (*CloudBoltJob, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+
+	var last *CloudBoltJob
+	seen := 0
+	for delay := opts.Interval; ; delay = opts.next(delay) {
+		job, err := c.GetJobWithContext(ctx, jobPath, true)
+		if err != nil {
+			return last, err
+		}
+
+		if opts.Progress != nil {
+			var messages []string
+			if len(job.ProgressMessages) > seen {
+				messages = job.ProgressMessages[seen:]
+				seen = len(job.ProgressMessages)
+			}
+
+			if last == nil || len(messages) > 0 || job.TasksDone != last.TasksDone || job.TotalTasks != last.TotalTasks {
+				opts.Progress(JobProgress{
+					Job:         job,
+					TasksDone:   job.TasksDone,
+					TotalTasks:  job.TotalTasks,
+					NewMessages: messages,
+				})
+			}
+		}
+		last = job
+
+		if job.Status.IsTerminal() {
+			if job.Status.IsSuccess() {
+				return job, nil
+			}
+
+			return job, &JobError{
+				JobPath: jobPath,
+				Status:  job.Status,
+				Errors:  job.Errors,
+				Output:  job.Output,
+				Job:     job,
+			}
+		}
+
+		if delay <= 0 {
+			delay = defaultWaitInterval
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return job, err
+		}
+	}
+}
+
+// WaitForOrder polls the Order with the given ID until it finishes, and returns what it did.
+// An order waiting for approval (PENDING) is polled until it is approved or denied,
+// then each of its jobs is followed with WaitForJob, using the same opts.
+// opts may be nil; its Progress callback is told about every job.
+//
+// An order that ends with FAILURE, DENIED or CANCELED is returned along with an *OrderError.
+// If ctx is done first, or a request fails, the result so far is returned with the error.
+func (c *CloudBoltClient) WaitForOrder(ctx context.Context, orderID string, opts *WaitOptions) (*OrderResult, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+
+	result := &OrderResult{}
+	var jobErrors []*JobError
+	followed := make(map[string]bool)
+	for delay := opts.Interval; ; {
+		order, err := c.GetOrderWithContext(ctx, orderID)
+		if err != nil {
+			return result, err
+		}
+		result.Order = order
+
+		// Jobs can be added while the order runs, so follow any we haven't yet
+		newJobs := false
+		for _, link := range order.Links.Jobs {
+			if followed[link.Href] {
+				continue
+			}
+			followed[link.Href] = true
+			newJobs = true
+
+			job, err := c.WaitForJob(ctx, link.Href, opts)
+			var jobErr *JobError
+			if errors.As(err, &jobErr) {
+				jobErrors = append(jobErrors, jobErr)
+			} else if err != nil {
+				return result, err
+			}
+			result.Jobs = append(result.Jobs, job)
+		}
+
+		if order.Status.IsTerminal() {
+			break
+		}
+
+		// Check again right away after following jobs, since the order is likely done by now
+		if !newJobs {
+			if delay <= 0 {
+				delay = defaultWaitInterval
+			}
+			if err := sleepContext(ctx, delay); err != nil {
+				return result, err
+			}
+			delay = opts.next(delay)
+		}
+	}
+
+	status, err := c.GetOrderStatusWithContext(ctx, orderID)
+	if err != nil {
+		return result, err
+	}
+	result.OutputMessages = status.OutputMessages
+	result.ErrorMessages = status.ErrorMessages
+	result.ProgressMessages = status.ProgressMessages
+
+	if err := c.fetchOrderObjects(ctx, result); err != nil {
+		return result, err
+	}
+
+	if !result.Order.Status.IsSuccess() {
+		return result, &OrderError{
+			OrderID:       orderID,
+			Status:        result.Order.Status,
+			ErrorMessages: status.ErrorMessages,
+			JobErrors:     jobErrors,
+		}
+	}
+
+	return result, nil
+}
+
+// fetchOrderObjects fetches the resources and servers linked from the result's jobs, once each.
+func (c *CloudBoltClient) fetchOrderObjects(ctx context.Context, result *OrderResult) error {
+	fetched := make(map[string]bool)
+	for _, job := range result.Jobs {
+		if href := job.Links.Resource.Href; href != "" && !fetched[href] {
+			fetched[href] = true
+
+			resource, err := c.GetResourceWithContext(ctx, href)
+			if err != nil {
+				return err
+			}
+			result.Resources = append(result.Resources, resource)
+		}
+
+		for _, link := range job.Links.Servers {
+			if link.Href == "" || fetched[link.Href] {
+				continue
+			}
+			fetched[link.Href] = true
+
+			server, err := c.GetServerWithContext(ctx, link.Href)
+			if err != nil {
+				return err
+			}
+			result.Servers = append(result.Servers, server)
+		}
+	}
+
+	return nil
+}
+
+// next returns the delay after one of the given length.
+func (o *WaitOptions) next(delay time.Duration) time.Duration {
+	if delay <= 0 {
+		delay = defaultWaitInterval
+	}
+	if o.Multiplier <= 1 {
+		return delay
+	}
+
+	delay = time.Duration(float64(delay) * o.Multiplier)
+	if o.MaxInterval > 0 && delay > o.MaxInterval {
+		delay = o.MaxInterval
+	}
+
+	return delay
+}
@@ -0,0 +1,217 @@
+package cbclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestWaitForJob(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForWaitForJob)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	var progress []JobProgress
+	job, err := client.WaitForJob(context.Background(), "/api/v3/cmp/jobs/JOB-9nrax3gb/", &WaitOptions{
+		Interval: time.Millisecond,
+		Progress: func(p JobProgress) {
+			progress = append(progress, p)
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(job).NotTo(BeNil())
+	Expect(job.Status).To(Equal(JobStatusSuccess))
+	Expect(job.Output).To(Equal("Blueprint deployed successfully"))
+
+	// This should have made six requests:
+	// 1+2. Fail to get the job, get a token
+	// 3-6. Poll the job until it succeeds
+	Expect(len(*requests)).To(Equal(6))
+	for _, request := range (*requests)[2:] {
+		Expect(request.URL.Path).To(Equal("/api/v3/cmp/jobs/JOB-9nrax3gb/"))
+		Expect(request.URL.Query().Get("includeProgress")).To(Equal("Y"))
+	}
+
+	// The second poll didn't change anything, so it isn't reported
+	Expect(progress).To(HaveLen(3))
+	Expect(progress[0].TasksDone).To(Equal(1))
+	Expect(progress[0].TotalTasks).To(Equal(3))
+	Expect(progress[0].NewMessages).To(Equal([]string{"Provisioning server myawainstance1"}))
+	Expect(progress[1].TasksDone).To(Equal(2))
+	Expect(progress[1].NewMessages).To(Equal([]string{"Running My Action on myawainstance1"}))
+	Expect(progress[2].TasksDone).To(Equal(3))
+	Expect(progress[2].NewMessages).To(BeEmpty())
+	Expect(progress[2].Job).To(Equal(job))
+}
+
+func TestWaitForJobFailure(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForWaitForJobFailure)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	job, err := client.WaitForJob(context.Background(), "/api/v3/cmp/jobs/JOB-9nrax3gb/", &WaitOptions{Interval: time.Millisecond})
+	Expect(err).To(HaveOccurred())
+	Expect(job).NotTo(BeNil())
+	Expect(job.Status).To(Equal(JobStatusFailure))
+	Expect(len(*requests)).To(Equal(4))
+
+	var jobErr *JobError
+	Expect(errors.As(err, &jobErr)).To(BeTrue())
+	Expect(jobErr.JobPath).To(Equal("/api/v3/cmp/jobs/JOB-9nrax3gb/"))
+	Expect(jobErr.Status).To(Equal(JobStatusFailure))
+	Expect(jobErr.Errors).To(Equal("My Action failed on myawainstance1"))
+	Expect(jobErr.Output).To(Equal("Provisioned myawainstance1"))
+	Expect(jobErr.Job).To(Equal(job))
+	Expect(err.Error()).To(Equal("job /api/v3/cmp/jobs/JOB-9nrax3gb/ finished with status FAILURE: My Action failed on myawainstance1"))
+}
+
+func TestWaitForJobContextDone(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForWaitForJobContextDone)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	job, err := client.WaitForJob(ctx, "/api/v3/cmp/jobs/JOB-9nrax3gb/", &WaitOptions{Interval: time.Millisecond})
+	Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	Expect(job).NotTo(BeNil())
+	Expect(job.Status).To(Equal(JobStatusRunning))
+	Expect(len(*requests)).To(BeNumerically(">", 3))
+}
+
+func TestWaitOptionsBackoff(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	fixed := &WaitOptions{Interval: time.Second}
+	Expect(fixed.next(time.Second)).To(Equal(time.Second))
+
+	backoff := &WaitOptions{Interval: time.Second, MaxInterval: 3 * time.Second, Multiplier: 2}
+	Expect(backoff.next(time.Second)).To(Equal(2 * time.Second))
+	Expect(backoff.next(2 * time.Second)).To(Equal(3 * time.Second))
+
+	defaults := &WaitOptions{}
+	Expect(defaults.next(0)).To(Equal(defaultWaitInterval))
+}
+
+func TestWaitForOrder(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForWaitForOrder)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	result, err := client.WaitForOrder(context.Background(), "ORD-e9v87uia", &WaitOptions{Interval: time.Millisecond})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(result).NotTo(BeNil())
+
+	// This should have made ten requests:
+	// 1+2. Fail to get the order, get a token
+	// 3+4. Poll the order until it's approved
+	// 5+6. Poll its job until it succeeds
+	// 7. Get the finished order
+	// 8. Get the order's messages
+	// 9+10. Get the resource and server the job created
+	Expect(len(*requests)).To(Equal(10))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/cmp/orders/ORD-e9v87uia/"))
+	Expect((*requests)[4].URL.Path).To(Equal("/api/v3/cmp/jobs/JOB-9nrax3gb/"))
+	Expect((*requests)[6].URL.Path).To(Equal("/api/v3/cmp/orders/ORD-e9v87uia/"))
+	Expect((*requests)[7].URL.Path).To(Equal("/api/v3/cmp/orders/ORD-e9v87uia/status/"))
+	Expect((*requests)[8].URL.Path).To(Equal("/api/v3/cmp/resources/RSC-hjt2wha2/"))
+	Expect((*requests)[9].URL.Path).To(Equal("/api/v3/cmp/servers/SVR-srb5y8r3/"))
+
+	Expect(result.Order.Status).To(Equal(OrderStatusSuccess))
+	Expect(result.Jobs).To(HaveLen(1))
+	Expect(result.Jobs[0].Status).To(Equal(JobStatusSuccess))
+	Expect(result.OutputMessages).To(Equal([]string{"Job 1011: Blueprint deployed successfully"}))
+	Expect(result.ErrorMessages).To(BeEmpty())
+	Expect(result.ProgressMessages).To(HaveLen(2))
+	Expect(result.Resources).To(HaveLen(1))
+	Expect(result.Resources[0].ID).To(Equal("RSC-hjt2wha2"))
+	Expect(result.Servers).To(HaveLen(1))
+	Expect(result.Servers[0].Hostname).To(Equal("myawsinstance1"))
+}
+
+func TestWaitForOrderFailure(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForWaitForOrderFailure)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	result, err := client.WaitForOrder(context.Background(), "ORD-e9v87uia", &WaitOptions{Interval: time.Millisecond})
+	Expect(err).To(HaveOccurred())
+	Expect(len(*requests)).To(Equal(6))
+
+	Expect(result).NotTo(BeNil())
+	Expect(result.Order.Status).To(Equal(OrderStatusFailure))
+	Expect(result.Jobs).To(HaveLen(1))
+	Expect(result.Resources).To(BeEmpty())
+	Expect(result.Servers).To(BeEmpty())
+
+	var orderErr *OrderError
+	Expect(errors.As(err, &orderErr)).To(BeTrue())
+	Expect(orderErr.OrderID).To(Equal("ORD-e9v87uia"))
+	Expect(orderErr.Status).To(Equal(OrderStatusFailure))
+	Expect(orderErr.ErrorMessages).To(Equal([]string{"Job 1011: My Action failed on myawainstance1"}))
+	Expect(orderErr.JobErrors).To(HaveLen(1))
+	Expect(orderErr.JobErrors[0].Errors).To(Equal("My Action failed on myawainstance1"))
+	Expect(err.Error()).To(Equal("order ORD-e9v87uia finished with status FAILURE: Job 1011: My Action failed on myawainstance1"))
+}
+
+func TestWaitForOrderDenied(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	server, requests := mockServer(responsesForWaitForOrderDenied)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+	defer server.Close()
+
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	result, err := client.WaitForOrder(context.Background(), "ORD-e9v87uia", &WaitOptions{Interval: time.Millisecond})
+	Expect(len(*requests)).To(Equal(5))
+	Expect(result.Jobs).To(BeEmpty())
+
+	var orderErr *OrderError
+	Expect(errors.As(err, &orderErr)).To(BeTrue())
+	Expect(orderErr.Status).To(Equal(OrderStatusDenied))
+	Expect(err.Error()).To(Equal("order ORD-e9v87uia finished with status DENIED"))
+}
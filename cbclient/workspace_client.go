@@ -1,10 +1,9 @@
 package cbclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
-	"net/url"
 )
 
 type WorkspaceResult struct {
@@ -14,6 +13,11 @@ type WorkspaceResult struct {
 	} `json:"_embedded"`
 }
 
+// Items returns the Workspaces on this page of results.
+func (r *WorkspaceResult) Items() []Workspace {
+	return r.Embedded.Workspaces
+}
+
 type Workspace struct {
 	Links *struct {
 		Self CloudBoltHALItem `json:"self,omitempty"`
@@ -23,24 +27,43 @@ type Workspace struct {
 }
 
 func (c *CloudBoltClient) GetDefaultWorkSpace() (*Workspace, error) {
-	log.Println("onefuse.apiClient: GetDefaultWorkSpace")
+	return c.GetDefaultWorkSpaceWithContext(context.Background())
+}
 
-	return c.GetWorkSpace("Default")
+// GetDefaultWorkSpaceWithContext is the same as GetDefaultWorkSpace with a caller-provided context.
+func (c *CloudBoltClient) GetDefaultWorkSpaceWithContext(ctx context.Context) (*Workspace, error) {
+	c.log().Debug("onefuse.apiClient: GetDefaultWorkSpace")
+
+	return c.GetWorkSpaceWithContext(ctx, "Default")
 }
 
-func (c *CloudBoltClient) GetWorkSpace(name string) (*Workspace, error) {
-	log.Println("onefuse.apiClient: GetWorkSpace")
+func (c *CloudBoltClient) GetWorkSpace(name string, filters ...*Filter) (*Workspace, error) {
+	return c.GetWorkSpaceWithContext(context.Background(), name, filters...)
+}
+
+// GetWorkSpaceWithContext is the same as GetWorkSpace with a caller-provided context.
+func (c *CloudBoltClient) GetWorkSpaceWithContext(ctx context.Context, name string, filters ...*Filter) (*Workspace, error) {
+	c.log().Debug("onefuse.apiClient: GetWorkSpace")
+
+	query, err := NewFilter().Eq("name", name).And(filters...).query()
+	if err != nil {
+		return nil, err
+	}
 
 	apiurl := c.baseURL
 	apiurl.Path = c.apiEndpoint("onefuse", "workspaces")
-	apiurl.RawQuery = fmt.Sprintf(filterByName, url.QueryEscape(name))
+	apiurl.RawQuery = query
 
-	resp, err := c.makeRequest("GET", apiurl.String(), nil)
+	resp, err := c.makeRequest(ctx, "GET", apiurl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// TODO: HTTP Response handling
+	err = checkHttpStatus(resp)
+	if err != nil {
+		return nil, err
+	}
 
 	var res WorkspaceResult
 	json.NewDecoder(resp.Body).Decode(&res)
@@ -55,3 +78,14 @@ func (c *CloudBoltClient) GetWorkSpace(name string) (*Workspace, error) {
 
 	return &res.Embedded.Workspaces[0], nil
 }
+
+// ListWorkSpaces fetches every Workspace the user can see, following all the pages of results.
+// opts may be nil; set its Filter to narrow down the list, e.g., by workspace.
+func (c *CloudBoltClient) ListWorkSpaces(opts *ListOptions) ([]Workspace, error) {
+	return c.ListWorkSpacesWithContext(context.Background(), opts)
+}
+
+// ListWorkSpacesWithContext is the same as ListWorkSpaces with a caller-provided context.
+func (c *CloudBoltClient) ListWorkSpacesWithContext(ctx context.Context, opts *ListOptions) ([]Workspace, error) {
+	return NewPaginator[Workspace, WorkspaceResult](c, c.apiEndpoint("onefuse", "workspaces"), opts).ListAll(ctx)
+}
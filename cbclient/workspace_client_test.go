@@ -72,3 +72,31 @@ func TestGetDefaultWorkspace(t *testing.T) {
 	Expect(workspace.Name).To(Equal("Default"))
 	Expect(workspace.ID).To(Equal(2))
 }
+
+func TestListWorkSpaces(t *testing.T) {
+	// Register the test with gomega
+	RegisterTestingT(t)
+
+	// Setup mock server with scripted responses
+	// Setup requests buffer
+	server, requests := mockServer(responsesForListWorkSpaces)
+	Expect(server).NotTo(BeNil())
+	Expect(requests).NotTo(BeNil())
+
+	// Setup CloudBolt Client
+	client := getClient(server)
+	Expect(client).NotTo(BeNil())
+
+	// List every one of them
+	workspaces, err := client.ListWorkSpaces(nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// This should have made three requests:
+	// 1+2. Fail to get the Workspaces, get a token
+	// 3. Successfully getting the only page of Workspaces
+	Expect(len(*requests)).To(Equal(3))
+	Expect((*requests)[2].URL.Path).To(Equal("/api/v3/onefuse/workspaces/"))
+
+	Expect(workspaces).To(HaveLen(1))
+	Expect(workspaces[0].ID).To(Equal(2))
+}